@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"outerspace-go/lib/http"
+	"outerspace-go/lib/tlsconfig"
 )
 
 var (
@@ -40,13 +41,25 @@ func main() {
 		}
 	}
 
+	// For https:// servers, optionally trust a private CA and present a
+	// client certificate (mutual TLS)
+	var opts []http.ClientOption
+	caFile, certFile, keyFile := os.Getenv("TLS_CA_FILE"), os.Getenv("TLS_CERT_FILE"), os.Getenv("TLS_KEY_FILE")
+	if caFile != "" || certFile != "" || keyFile != "" {
+		tlsConfig, err := tlsconfig.Client(caFile, certFile, keyFile)
+		if err != nil {
+			log.Fatalf("Invalid TLS configuration: %v", err)
+		}
+		opts = append(opts, http.WithTLS(tlsConfig))
+	}
+
 	fmt.Printf("Server: %s, Poll interval: %v\n", serverAddr, interval)
 
 	// Main loop
 	for {
 		fmt.Printf("\n[%s] Starting client execution cycle\n", time.Now().Format(time.RFC3339))
 
-		if err := executeClientCycle(serverAddr); err != nil {
+		if err := executeClientCycle(serverAddr, opts...); err != nil {
 			log.Printf("Client cycle failed: %v", err)
 		}
 
@@ -55,9 +68,9 @@ func main() {
 	}
 }
 
-func executeClientCycle(serverAddr string) error {
+func executeClientCycle(serverAddr string, opts ...http.ClientOption) error {
 	// Create a new client
-	client := http.NewClient(serverAddr)
+	client := http.NewClient(serverAddr, opts...)
 	defer client.Close()
 
 	// Create a context with timeout
@@ -0,0 +1,63 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// MaxBatchIDs is the number of ids a single batch request may ask for
+	MaxBatchIDs = 50
+	// BatchConcurrency bounds the upstream calls made at once for a batch
+	BatchConcurrency = 4
+)
+
+// RocketResult is the outcome of fetching one rocket of a batch. Exactly one
+// of Rocket and Err is set.
+type RocketResult struct {
+	ID     string
+	Rocket *Rocket
+	Err    error
+}
+
+// ValidateBatchIDs checks the ids of a batch request
+func ValidateBatchIDs(ids []string) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("at least one rocket ID is required")
+	}
+	if len(ids) > MaxBatchIDs {
+		return fmt.Errorf("at most %d rocket IDs may be requested at once, got %d", MaxBatchIDs, len(ids))
+	}
+	for i, id := range ids {
+		if id == "" {
+			return fmt.Errorf("rocket ID %d is empty", i+1)
+		}
+	}
+	return nil
+}
+
+// GetRocketsByID fetches the rockets with the given ids, at most
+// BatchConcurrency at a time. It returns one result per id in the order the
+// ids were given; a failed id does not affect the others.
+func GetRocketsByID(ctx context.Context, client SpaceXClientInterface, ids []string) []RocketResult {
+	results := make([]RocketResult, len(ids))
+
+	var g errgroup.Group
+	g.SetLimit(BatchConcurrency)
+	for i, id := range ids {
+		g.Go(func() error {
+			rocket, err := client.GetRocket(ctx, id)
+			if err != nil {
+				results[i] = RocketResult{ID: id, Err: err}
+				return nil
+			}
+			results[i] = RocketResult{ID: id, Rocket: rocket}
+			return nil
+		})
+	}
+	g.Wait()
+
+	return results
+}
@@ -0,0 +1,70 @@
+package lib
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBatchIDs(t *testing.T) {
+	assert.NoError(t, ValidateBatchIDs([]string{"a", "b", "a"}))
+	assert.Error(t, ValidateBatchIDs(nil))
+	assert.Error(t, ValidateBatchIDs([]string{"a", "", "b"}))
+	assert.Error(t, ValidateBatchIDs(make([]string, MaxBatchIDs+1)))
+}
+
+func TestGetRocketsByID(t *testing.T) {
+	notFound := &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrNotFound, StatusCode: 404}
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetRocket", mock.Anything, "falcon9").Return(&Rocket{ID: "falcon9", Name: "Falcon 9"}, nil)
+	mockClient.On("GetRocket", mock.Anything, "unknown").Return(nil, notFound)
+	mockClient.On("GetRocket", mock.Anything, "starship").Return(&Rocket{ID: "starship", Name: "Starship"}, nil)
+
+	results := GetRocketsByID(context.Background(), mockClient, []string{"starship", "unknown", "falcon9"})
+
+	require.Len(t, results, 3)
+	assert.Equal(t, "starship", results[0].ID)
+	assert.Equal(t, "Starship", results[0].Rocket.Name)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "unknown", results[1].ID)
+	assert.Nil(t, results[1].Rocket)
+	assert.ErrorIs(t, results[1].Err, upstream.ErrNotFound)
+	assert.Equal(t, "falcon9", results[2].ID)
+	assert.Equal(t, "Falcon 9", results[2].Rocket.Name)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetRocketsByID_BoundsConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetRocket", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}).Return(&Rocket{}, nil)
+
+	ids := make([]string, 3*BatchConcurrency)
+	for i := range ids {
+		ids[i] = string(rune('a' + i))
+	}
+	results := GetRocketsByID(context.Background(), mockClient, ids)
+
+	require.Len(t, results, len(ids))
+	for i, result := range results {
+		assert.Equal(t, ids[i], result.ID)
+	}
+	assert.Equal(t, BatchConcurrency, maxInFlight)
+}
@@ -0,0 +1,160 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sync/singleflight"
+)
+
+// TTL controls how long a cached value is used
+type TTL struct {
+	// Fresh is how long a value is served without asking the upstream.
+	// Zero disables caching.
+	Fresh time.Duration
+	// Stale is how long after going stale a value may still be served while
+	// it is refreshed in the background (stale-while-revalidate)
+	Stale time.Duration
+}
+
+type entry struct {
+	key        string
+	value      any
+	freshUntil time.Time
+	staleUntil time.Time
+}
+
+// Cache is a size-bounded, in-memory LRU cache. Concurrent misses for the
+// same key are collapsed into a single load, and stale values are served
+// while they are refreshed in the background.
+type Cache struct {
+	maxEntries int
+	now        func() time.Time
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+	group singleflight.Group
+}
+
+// New creates a cache holding at most maxEntries values
+func New(maxEntries int) *Cache {
+	return &Cache{
+		maxEntries: maxEntries,
+		now:        time.Now,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Len returns the number of cached values
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+// lookup returns the entry for key, if any, marking it as recently used
+func (c *Cache) lookup(key string) (*entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*entry), true
+}
+
+// store adds or replaces the value for key, evicting the least recently
+// used values beyond the size bound
+func (c *Cache) store(key string, value any, ttl TTL) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	e := &entry{
+		key:        key,
+		value:      value,
+		freshUntil: now.Add(ttl.Fresh),
+		staleUntil: now.Add(ttl.Fresh + ttl.Stale),
+	}
+	if el, ok := c.items[key]; ok {
+		el.Value = e
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(e)
+	for c.maxEntries > 0 && c.ll.Len() > c.maxEntries {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*entry).key)
+	}
+}
+
+// get returns the value for key, calling load on a miss. The load runs
+// detached from the caller's cancellation so that its result can still be
+// cached for others, while each caller stops waiting once its ctx is done.
+func (c *Cache) get(ctx context.Context, key string, ttl TTL, load func(context.Context) (any, error)) (any, error) {
+	if ttl.Fresh <= 0 {
+		return load(ctx)
+	}
+
+	if e, ok := c.lookup(key); ok {
+		now := c.now()
+		if now.Before(e.freshUntil) {
+			return e.value, nil
+		}
+		if now.Before(e.staleUntil) {
+			c.refresh(ctx, key, ttl, load)
+			return e.value, nil
+		}
+	}
+
+	ch := c.load(ctx, key, ttl, load)
+	select {
+	case res := <-ch:
+		return res.Val, res.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// load starts, or joins, the single in-flight load for key
+func (c *Cache) load(ctx context.Context, key string, ttl TTL, load func(context.Context) (any, error)) <-chan singleflight.Result {
+	detached := context.WithoutCancel(ctx)
+	return c.group.DoChan(key, func() (any, error) {
+		value, err := load(detached)
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, value, ttl)
+		return value, nil
+	})
+}
+
+// refresh reloads a stale value in the background
+func (c *Cache) refresh(ctx context.Context, key string, ttl TTL, load func(context.Context) (any, error)) {
+	ch := c.load(ctx, key, ttl, load)
+	go func() {
+		if res := <-ch; res.Err != nil {
+			log.Warn().Str("key", key).Err(res.Err).Msg("Cache refresh failed, serving stale value")
+		}
+	}()
+}
+
+// Get returns the cached value for key, loading it on a miss
+func Get[T any](ctx context.Context, c *Cache, key string, ttl TTL, load func(context.Context) (T, error)) (T, error) {
+	value, err := c.get(ctx, key, ttl, func(ctx context.Context) (any, error) {
+		return load(ctx)
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return value.(T), nil
+}
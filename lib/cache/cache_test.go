@@ -0,0 +1,220 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced clock for cache tests
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestCache(maxEntries int) (*Cache, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 7, 23, 12, 0, 0, 0, time.UTC)}
+	c := New(maxEntries)
+	c.now = clock.Now
+	return c, clock
+}
+
+// counter returns a loader that yields an increasing number on every call
+func counter() (func(context.Context) (int, error), *atomic.Int32) {
+	var calls atomic.Int32
+	return func(context.Context) (int, error) {
+		return int(calls.Add(1)), nil
+	}, &calls
+}
+
+var minuteTTL = TTL{Fresh: time.Minute}
+
+func TestGet_CachesUntilExpiry(t *testing.T) {
+	c, clock := newTestCache(10)
+	load, calls := counter()
+
+	v, err := Get(context.Background(), c, "k", minuteTTL, load)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	clock.Advance(59 * time.Second)
+	v, _ = Get(context.Background(), c, "k", minuteTTL, load)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, int32(1), calls.Load())
+
+	clock.Advance(time.Second)
+	v, _ = Get(context.Background(), c, "k", minuteTTL, load)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestGet_ZeroTTLDisablesCaching(t *testing.T) {
+	c, _ := newTestCache(10)
+	load, calls := counter()
+
+	Get(context.Background(), c, "k", TTL{}, load)
+	Get(context.Background(), c, "k", TTL{}, load)
+
+	assert.Equal(t, int32(2), calls.Load())
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestGet_ErrorsAreNotCached(t *testing.T) {
+	c, _ := newTestCache(10)
+	fail := true
+	load := func(context.Context) (string, error) {
+		if fail {
+			return "", errors.New("upstream down")
+		}
+		return "ok", nil
+	}
+
+	_, err := Get(context.Background(), c, "k", minuteTTL, load)
+	assert.Error(t, err)
+
+	fail = false
+	v, err := Get(context.Background(), c, "k", minuteTTL, load)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", v)
+}
+
+func TestGet_EvictsLeastRecentlyUsed(t *testing.T) {
+	c, _ := newTestCache(2)
+	load, calls := counter()
+
+	Get(context.Background(), c, "a", minuteTTL, load)
+	Get(context.Background(), c, "b", minuteTTL, load)
+	// Touch a so that b becomes the least recently used entry
+	Get(context.Background(), c, "a", minuteTTL, load)
+	Get(context.Background(), c, "c", minuteTTL, load)
+
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, int32(3), calls.Load())
+
+	Get(context.Background(), c, "a", minuteTTL, load)
+	assert.Equal(t, int32(3), calls.Load())
+	Get(context.Background(), c, "b", minuteTTL, load)
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func TestGet_DeduplicatesConcurrentMisses(t *testing.T) {
+	c, _ := newTestCache(10)
+	release := make(chan struct{})
+	var calls atomic.Int32
+	load := func(context.Context) (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = Get(context.Background(), c, "k", minuteTTL, load)
+		}()
+	}
+
+	// Give every goroutine the chance to join the in-flight load
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+	for _, v := range results {
+		assert.Equal(t, 42, v)
+	}
+}
+
+func TestGet_StaleWhileRevalidate(t *testing.T) {
+	c, clock := newTestCache(10)
+	ttl := TTL{Fresh: time.Minute, Stale: time.Hour}
+	refreshed := make(chan struct{})
+	var calls atomic.Int32
+	load := func(context.Context) (int, error) {
+		n := int(calls.Add(1))
+		if n == 2 {
+			defer close(refreshed)
+		}
+		return n, nil
+	}
+
+	Get(context.Background(), c, "k", ttl, load)
+	clock.Advance(2 * time.Minute)
+
+	// The stale value is served right away while a refresh runs
+	v, err := Get(context.Background(), c, "k", ttl, load)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("stale value was not refreshed")
+	}
+	require.Eventually(t, func() bool {
+		v, _ := Get(context.Background(), c, "k", ttl, load)
+		return v == 2
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestGet_BeyondStaleWindowReloads(t *testing.T) {
+	c, clock := newTestCache(10)
+	ttl := TTL{Fresh: time.Minute, Stale: time.Minute}
+	load, calls := counter()
+
+	Get(context.Background(), c, "k", ttl, load)
+	clock.Advance(3 * time.Minute)
+
+	v, _ := Get(context.Background(), c, "k", ttl, load)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestGet_CallerCancellationDoesNotAbortLoad(t *testing.T) {
+	c, _ := newTestCache(10)
+	release := make(chan struct{})
+	load := func(ctx context.Context) (int, error) {
+		<-release
+		return 7, ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := Get(ctx, c, "k", minuteTTL, load)
+		done <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+
+	// The abandoned load still completes and populates the cache
+	close(release)
+	require.Eventually(t, func() bool { return c.Len() == 1 }, time.Second, time.Millisecond)
+	v, err := Get(context.Background(), c, "k", minuteTTL, load)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, v)
+}
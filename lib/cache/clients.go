@@ -0,0 +1,155 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	"outerspace-go/lib"
+)
+
+// SpaceXTTLs holds the cache TTL of each SpaceX API method
+type SpaceXTTLs struct {
+	// Rockets applies to GetAllRockets and GetRocket
+	Rockets TTL
+	// Fleet applies to the capsule, core, crew, launchpad and landpad methods
+	Fleet TTL
+	// LatestLaunch applies to GetLatestLaunch, GetNextLaunch and
+	// GetUpcomingLaunches
+	LatestLaunch TTL
+	// Launches applies to ListLaunches and GetLaunch
+	Launches TTL
+}
+
+// SpaceXClient is a caching decorator for a lib.SpaceXClientInterface.
+// Values handed out are shared between callers and must not be modified.
+type SpaceXClient struct {
+	lib.SpaceXClientInterface
+	cache *Cache
+	ttls  SpaceXTTLs
+}
+
+// NewSpaceXClient wraps client with the cache
+func NewSpaceXClient(client lib.SpaceXClientInterface, cache *Cache, ttls SpaceXTTLs) *SpaceXClient {
+	return &SpaceXClient{SpaceXClientInterface: client, cache: cache, ttls: ttls}
+}
+
+// GetAllRockets returns the cached rocket summaries
+func (c *SpaceXClient) GetAllRockets(ctx context.Context) ([]lib.RocketSummary, error) {
+	return Get(ctx, c.cache, "spacex:rockets", c.ttls.Rockets, c.SpaceXClientInterface.GetAllRockets)
+}
+
+// GetRocket returns the cached rocket with the given ID
+func (c *SpaceXClient) GetRocket(ctx context.Context, id string) (*lib.Rocket, error) {
+	return Get(ctx, c.cache, "spacex:rocket:"+id, c.ttls.Rockets, func(ctx context.Context) (*lib.Rocket, error) {
+		return c.SpaceXClientInterface.GetRocket(ctx, id)
+	})
+}
+
+// GetAllCapsules returns the cached list of capsules
+func (c *SpaceXClient) GetAllCapsules(ctx context.Context) ([]lib.Capsule, error) {
+	return Get(ctx, c.cache, "spacex:capsules", c.ttls.Fleet, c.SpaceXClientInterface.GetAllCapsules)
+}
+
+// GetCapsule returns the cached details of a capsule
+func (c *SpaceXClient) GetCapsule(ctx context.Context, id string) (*lib.Capsule, error) {
+	return Get(ctx, c.cache, "spacex:capsule:"+id, c.ttls.Fleet, func(ctx context.Context) (*lib.Capsule, error) {
+		return c.SpaceXClientInterface.GetCapsule(ctx, id)
+	})
+}
+
+// GetAllCores returns the cached list of cores
+func (c *SpaceXClient) GetAllCores(ctx context.Context) ([]lib.Core, error) {
+	return Get(ctx, c.cache, "spacex:cores", c.ttls.Fleet, c.SpaceXClientInterface.GetAllCores)
+}
+
+// GetAllCrew returns the cached list of crew members
+func (c *SpaceXClient) GetAllCrew(ctx context.Context) ([]lib.CrewMember, error) {
+	return Get(ctx, c.cache, "spacex:crew", c.ttls.Fleet, c.SpaceXClientInterface.GetAllCrew)
+}
+
+// GetAllLaunchpads returns the cached list of launchpads
+func (c *SpaceXClient) GetAllLaunchpads(ctx context.Context) ([]lib.Launchpad, error) {
+	return Get(ctx, c.cache, "spacex:launchpads", c.ttls.Fleet, c.SpaceXClientInterface.GetAllLaunchpads)
+}
+
+// GetLaunchpad returns the cached details of a launchpad
+func (c *SpaceXClient) GetLaunchpad(ctx context.Context, id string) (*lib.Launchpad, error) {
+	return Get(ctx, c.cache, "spacex:launchpad:"+id, c.ttls.Fleet, func(ctx context.Context) (*lib.Launchpad, error) {
+		return c.SpaceXClientInterface.GetLaunchpad(ctx, id)
+	})
+}
+
+// GetAllLandpads returns the cached list of landpads
+func (c *SpaceXClient) GetAllLandpads(ctx context.Context) ([]lib.Landpad, error) {
+	return Get(ctx, c.cache, "spacex:landpads", c.ttls.Fleet, c.SpaceXClientInterface.GetAllLandpads)
+}
+
+// GetLatestLaunch returns the cached latest launch
+func (c *SpaceXClient) GetLatestLaunch(ctx context.Context) (*lib.Launch, error) {
+	return Get(ctx, c.cache, "spacex:launches:latest", c.ttls.LatestLaunch, c.SpaceXClientInterface.GetLatestLaunch)
+}
+
+// GetNextLaunch returns the cached next launch
+func (c *SpaceXClient) GetNextLaunch(ctx context.Context) (*lib.Launch, error) {
+	return Get(ctx, c.cache, "spacex:launches:next", c.ttls.LatestLaunch, c.SpaceXClientInterface.GetNextLaunch)
+}
+
+// GetUpcomingLaunches returns the cached upcoming launches
+func (c *SpaceXClient) GetUpcomingLaunches(ctx context.Context) ([]lib.Launch, error) {
+	return Get(ctx, c.cache, "spacex:launches:upcoming", c.ttls.LatestLaunch, c.SpaceXClientInterface.GetUpcomingLaunches)
+}
+
+// ListLaunches returns the cached page of launches matching query
+func (c *SpaceXClient) ListLaunches(ctx context.Context, query lib.LaunchQuery) (*lib.LaunchPage, error) {
+	return Get(ctx, c.cache, "spacex:launches:query:"+query.Key(), c.ttls.Launches, func(ctx context.Context) (*lib.LaunchPage, error) {
+		return c.SpaceXClientInterface.ListLaunches(ctx, query)
+	})
+}
+
+// GetLaunch returns the cached details of a launch, cached separately with
+// and without populated references
+func (c *SpaceXClient) GetLaunch(ctx context.Context, id string, populate bool) (*lib.LaunchDetail, error) {
+	key := fmt.Sprintf("spacex:launch:%s:%t", id, populate)
+	return Get(ctx, c.cache, key, c.ttls.Launches, func(ctx context.Context) (*lib.LaunchDetail, error) {
+		return c.SpaceXClientInterface.GetLaunch(ctx, id, populate)
+	})
+}
+
+// NumbersClient is a caching decorator for a lib.NumbersClientInterface
+type NumbersClient struct {
+	lib.NumbersClientInterface
+	cache *Cache
+	ttl   TTL
+}
+
+// NewNumbersClient wraps client with the cache. The Numbers API returns a
+// random fact on every call, so a zero TTL that disables caching is usually
+// what you want.
+func NewNumbersClient(client lib.NumbersClientInterface, cache *Cache, ttl TTL) *NumbersClient {
+	return &NumbersClient{NumbersClientInterface: client, cache: cache, ttl: ttl}
+}
+
+// GetMathFact returns the cached math fact
+func (c *NumbersClient) GetMathFact(ctx context.Context) (*lib.MathFact, error) {
+	return Get(ctx, c.cache, "numbers:math", c.ttl, c.NumbersClientInterface.GetMathFact)
+}
+
+// NASAClient is a caching decorator for a lib.NASAClientInterface
+type NASAClient struct {
+	lib.NASAClientInterface
+	cache *Cache
+	ttl   TTL
+}
+
+// NewNASAClient wraps client with the cache
+func NewNASAClient(client lib.NASAClientInterface, cache *Cache, ttl TTL) *NASAClient {
+	return &NASAClient{NASAClientInterface: client, cache: cache, ttl: ttl}
+}
+
+// GetAPOD returns the cached Astronomy Picture of the Day for date, or for
+// today when date is empty
+func (c *NASAClient) GetAPOD(ctx context.Context, date string) (*lib.APOD, error) {
+	return Get(ctx, c.cache, "nasa:apod:"+date, c.ttl, func(ctx context.Context) (*lib.APOD, error) {
+		return c.NASAClientInterface.GetAPOD(ctx, date)
+	})
+}
@@ -0,0 +1,339 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"outerspace-go/lib"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Mock SpaceX client
+type MockSpaceXClient struct {
+	mock.Mock
+}
+
+func (m *MockSpaceXClient) GetAllRockets(ctx context.Context) ([]lib.RocketSummary, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]lib.RocketSummary), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetRocket(ctx context.Context, id string) (*lib.Rocket, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Rocket), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllCapsules(ctx context.Context) ([]lib.Capsule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Capsule), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetCapsule(ctx context.Context, id string) (*lib.Capsule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Capsule), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllCores(ctx context.Context) ([]lib.Core, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Core), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllCrew(ctx context.Context) ([]lib.CrewMember, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.CrewMember), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllLaunchpads(ctx context.Context) ([]lib.Launchpad, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Launchpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLaunchpad(ctx context.Context, id string) (*lib.Launchpad, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Launchpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllLandpads(ctx context.Context) ([]lib.Landpad, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Landpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLatestLaunch(ctx context.Context) (*lib.Launch, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Launch), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetNextLaunch(ctx context.Context) (*lib.Launch, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Launch), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetUpcomingLaunches(ctx context.Context) ([]lib.Launch, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Launch), args.Error(1)
+}
+
+func (m *MockSpaceXClient) ListLaunches(ctx context.Context, query lib.LaunchQuery) (*lib.LaunchPage, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.LaunchPage), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLaunch(ctx context.Context, id string, populate bool) (*lib.LaunchDetail, error) {
+	args := m.Called(ctx, id, populate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.LaunchDetail), args.Error(1)
+}
+
+// Mock Numbers client
+type MockNumbersClient struct {
+	mock.Mock
+}
+
+func (m *MockNumbersClient) GetMathFact(ctx context.Context) (*lib.MathFact, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.MathFact), args.Error(1)
+}
+
+// Mock NASA client
+type MockNASAClient struct {
+	mock.Mock
+}
+
+func (m *MockNASAClient) GetAPOD(ctx context.Context, date string) (*lib.APOD, error) {
+	args := m.Called(ctx, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.APOD), args.Error(1)
+}
+
+var testTTLs = SpaceXTTLs{
+	Rockets:      TTL{Fresh: time.Hour},
+	Fleet:        TTL{Fresh: time.Hour},
+	LatestLaunch: TTL{Fresh: time.Minute},
+	Launches:     TTL{Fresh: time.Minute},
+}
+
+func TestSpaceXClient_CachesRockets(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllRockets", mock.Anything).Return([]lib.RocketSummary{{ID: "123", Name: "Falcon 9"}}, nil).Once()
+	mockClient.On("GetRocket", mock.Anything, "123").Return(&lib.Rocket{ID: "123"}, nil).Once()
+	mockClient.On("GetRocket", mock.Anything, "456").Return(&lib.Rocket{ID: "456"}, nil).Once()
+
+	client := NewSpaceXClient(mockClient, New(100), testTTLs)
+
+	for i := 0; i < 3; i++ {
+		rockets, err := client.GetAllRockets(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "Falcon 9", rockets[0].Name)
+
+		rocket, err := client.GetRocket(context.Background(), "123")
+		assert.NoError(t, err)
+		assert.Equal(t, "123", rocket.ID)
+
+		rocket, err = client.GetRocket(context.Background(), "456")
+		assert.NoError(t, err)
+		assert.Equal(t, "456", rocket.ID)
+	}
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestSpaceXClient_CachesFleet(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllCapsules", mock.Anything).Return([]lib.Capsule{{ID: "1"}}, nil).Once()
+	mockClient.On("GetCapsule", mock.Anything, "1").Return(&lib.Capsule{ID: "1"}, nil).Once()
+	mockClient.On("GetAllCores", mock.Anything).Return([]lib.Core{{ID: "2"}}, nil).Once()
+	mockClient.On("GetAllCrew", mock.Anything).Return([]lib.CrewMember{{ID: "3"}}, nil).Once()
+	mockClient.On("GetAllLaunchpads", mock.Anything).Return([]lib.Launchpad{{ID: "4"}}, nil).Once()
+	mockClient.On("GetLaunchpad", mock.Anything, "4").Return(&lib.Launchpad{ID: "4"}, nil).Once()
+	mockClient.On("GetAllLandpads", mock.Anything).Return([]lib.Landpad{{ID: "5"}}, nil).Once()
+
+	client := NewSpaceXClient(mockClient, New(100), testTTLs)
+
+	for i := 0; i < 2; i++ {
+		capsules, err := client.GetAllCapsules(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "1", capsules[0].ID)
+
+		capsule, err := client.GetCapsule(context.Background(), "1")
+		assert.NoError(t, err)
+		assert.Equal(t, "1", capsule.ID)
+
+		cores, err := client.GetAllCores(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "2", cores[0].ID)
+
+		crew, err := client.GetAllCrew(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "3", crew[0].ID)
+
+		launchpads, err := client.GetAllLaunchpads(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "4", launchpads[0].ID)
+
+		launchpad, err := client.GetLaunchpad(context.Background(), "4")
+		assert.NoError(t, err)
+		assert.Equal(t, "4", launchpad.ID)
+
+		landpads, err := client.GetAllLandpads(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "5", landpads[0].ID)
+	}
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestSpaceXClient_CachesLatestLaunch(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 100}, nil).Once()
+
+	client := NewSpaceXClient(mockClient, New(100), testTTLs)
+
+	client.GetLatestLaunch(context.Background())
+	launch, err := client.GetLatestLaunch(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100, launch.FlightNumber)
+	mockClient.AssertExpectations(t)
+}
+
+func TestSpaceXClient_CachesLaunchesPerQuery(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("ListLaunches", mock.Anything, lib.LaunchQuery{}).Return(&lib.LaunchPage{Page: 1}, nil).Once()
+	mockClient.On("ListLaunches", mock.Anything, lib.LaunchQuery{Page: 2}).Return(&lib.LaunchPage{Page: 2}, nil).Once()
+
+	client := NewSpaceXClient(mockClient, New(100), testTTLs)
+
+	for i := 0; i < 2; i++ {
+		page, err := client.ListLaunches(context.Background(), lib.LaunchQuery{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, page.Page)
+
+		page, err = client.ListLaunches(context.Background(), lib.LaunchQuery{Page: 2})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, page.Page)
+	}
+	// The first page asked for explicitly is the same query as the default
+	page, err := client.ListLaunches(context.Background(), lib.LaunchQuery{Page: 1, Limit: lib.DefaultLaunchPageSize})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, page.Page)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestSpaceXClient_CachesLaunchPerPopulate(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetLaunch", mock.Anything, "abc", false).Return(&lib.LaunchDetail{ID: "abc"}, nil).Once()
+	mockClient.On("GetLaunch", mock.Anything, "abc", true).Return(&lib.LaunchDetail{ID: "abc", Rocket: lib.Ref[lib.Rocket]{ID: "falcon9", Value: &lib.Rocket{}}}, nil).Once()
+
+	client := NewSpaceXClient(mockClient, New(100), testTTLs)
+
+	for i := 0; i < 2; i++ {
+		launch, err := client.GetLaunch(context.Background(), "abc", false)
+		assert.NoError(t, err)
+		assert.Nil(t, launch.Rocket.Value)
+
+		launch, err = client.GetLaunch(context.Background(), "abc", true)
+		assert.NoError(t, err)
+		assert.NotNil(t, launch.Rocket.Value)
+	}
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestSpaceXClient_CachesNextAndUpcomingLaunches(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetNextLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 187}, nil).Once()
+	mockClient.On("GetUpcomingLaunches", mock.Anything).Return([]lib.Launch{{FlightNumber: 187}, {FlightNumber: 188}}, nil).Once()
+
+	client := NewSpaceXClient(mockClient, New(100), testTTLs)
+
+	for i := 0; i < 2; i++ {
+		launch, err := client.GetNextLaunch(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 187, launch.FlightNumber)
+
+		launches, err := client.GetUpcomingLaunches(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, launches, 2)
+	}
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestNumbersClient_ZeroTTLPassesThrough(t *testing.T) {
+	mockClient := new(MockNumbersClient)
+	mockClient.On("GetMathFact", mock.Anything).Return(&lib.MathFact{Number: 42}, nil).Twice()
+
+	client := NewNumbersClient(mockClient, New(100), TTL{})
+
+	client.GetMathFact(context.Background())
+	client.GetMathFact(context.Background())
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestNASAClient_CachesAPODPerDate(t *testing.T) {
+	mockClient := new(MockNASAClient)
+	mockClient.On("GetAPOD", mock.Anything, "").Return(&lib.APOD{Title: "Today"}, nil).Once()
+	mockClient.On("GetAPOD", mock.Anything, "2024-04-08").Return(&lib.APOD{Title: "Eclipse"}, nil).Once()
+
+	client := NewNASAClient(mockClient, New(100), TTL{Fresh: time.Hour})
+
+	for i := 0; i < 2; i++ {
+		apod, err := client.GetAPOD(context.Background(), "")
+		assert.NoError(t, err)
+		assert.Equal(t, "Today", apod.Title)
+
+		apod, err = client.GetAPOD(context.Background(), "2024-04-08")
+		assert.NoError(t, err)
+		assert.Equal(t, "Eclipse", apod.Title)
+	}
+
+	mockClient.AssertExpectations(t)
+}
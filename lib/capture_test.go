@@ -0,0 +1,92 @@
+package lib
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// capturedResponse is the part of a proxymock capture's internal record that
+// holds the upstream response
+type capturedResponse struct {
+	HTTP struct {
+		Res struct {
+			StatusCode int    `json:"statusCode"`
+			BodyBase64 string `json:"bodyBase64"`
+		} `json:"res"`
+	} `json:"http"`
+}
+
+// findCapture returns the most recent proxymock capture of a GET of path on
+// host, from the recordings kept in the repository's proxymock directory
+func findCapture(t *testing.T, host, path string) string {
+	files, err := filepath.Glob(filepath.Join("..", "proxymock", "*", host, "*.md"))
+	require.NoError(t, err)
+	// Recording directories and capture files are named after their time
+	sort.Sort(sort.Reverse(sort.StringSlice(files)))
+
+	signature := "http:url is " + path
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		require.NoError(t, err)
+		for _, line := range strings.Split(string(data), "\n") {
+			if line == signature {
+				return file
+			}
+		}
+	}
+	t.Fatalf("no proxymock capture of %s%s, record one with make http-test-recording", host, path)
+	return ""
+}
+
+// readCapture decodes the response recorded in a proxymock capture file
+func readCapture(t *testing.T, file string) (int, []byte) {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16<<20)
+	for scanner.Scan() {
+		record, ok := strings.CutPrefix(scanner.Text(), "json: ")
+		if !ok {
+			continue
+		}
+		var captured capturedResponse
+		require.NoError(t, json.Unmarshal([]byte(record), &captured))
+		body, err := base64.StdEncoding.DecodeString(captured.HTTP.Res.BodyBase64)
+		require.NoError(t, err)
+		return captured.HTTP.Res.StatusCode, body
+	}
+	require.NoError(t, scanner.Err())
+	t.Fatalf("%s has no internal record", file)
+	return 0, nil
+}
+
+// serveCapture answers requests for path with the response SpaceX gave in
+// the latest proxymock recording of it and returns the base URL of the API
+func serveCapture(t *testing.T, path string) string {
+	status, body := readCapture(t, findCapture(t, "api.spacexdata.com", path))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, path, r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL + "/v4"
+}
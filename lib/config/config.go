@@ -0,0 +1,492 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"outerspace-go/lib"
+	"outerspace-go/lib/upstream"
+
+	"github.com/rs/zerolog"
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds the runtime settings for the outerspace-go server.
+//
+// Values are resolved with the following precedence, highest first:
+//
+//  1. command-line flags
+//  2. environment variables
+//  3. the config file given by -config or CONFIG_FILE (YAML or JSON)
+//  4. built-in defaults
+type Config struct {
+	// HTTPAddr is the listen address of the plaintext REST API. It may be
+	// empty when TLS is enabled, to only serve the API over HTTPS.
+	HTTPAddr string `yaml:"http_addr"`
+	// HTTPSAddr is the listen address of the REST API over TLS, only used
+	// when TLS is enabled
+	HTTPSAddr string `yaml:"https_addr"`
+	// GRPCAddr is the listen address of the gRPC LaunchService
+	GRPCAddr string `yaml:"grpc_addr"`
+	// GRPCHealthAddr, if set, is the listen address of a plaintext gRPC
+	// server that only serves health checks, for probes that cannot use TLS
+	GRPCHealthAddr string `yaml:"grpc_health_addr"`
+	// GRPCReflection registers the gRPC server reflection service so tools
+	// such as grpcurl can discover the API
+	GRPCReflection bool `yaml:"grpc_reflection"`
+	// LaunchWatchInterval is how often WatchLatestLaunch streams poll SpaceX
+	LaunchWatchInterval time.Duration `yaml:"launch_watch_interval"`
+	// ShutdownTimeout bounds how long in-flight requests may drain on SIGTERM
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+
+	// TLSCertFile and TLSKeyFile hold the PEM key pair presented by the HTTPS
+	// and gRPC listeners. Setting both enables TLS; the files are re-read
+	// when they change.
+	TLSCertFile string `yaml:"tls_cert_file"`
+	TLSKeyFile  string `yaml:"tls_key_file"`
+	// TLSClientCAFile is a PEM bundle of CAs that client certificates must
+	// be signed by. Setting it enables mutual TLS.
+	TLSClientCAFile string `yaml:"tls_client_ca_file"`
+
+	// SpaceXBaseURL is the base URL of the SpaceX API
+	SpaceXBaseURL string `yaml:"spacex_base_url"`
+	// NumbersBaseURL is the base URL of the Numbers API
+	NumbersBaseURL string `yaml:"numbers_base_url"`
+	// NASABaseURL is the base URL of the NASA API
+	NASABaseURL string `yaml:"nasa_base_url"`
+	// NASAAPIKey is the NASA API key. It is only read from the environment so
+	// that it never ends up in config files or process listings.
+	NASAAPIKey string `yaml:"-"`
+	// NASAAPIKeyFile is a file holding the NASA API key, e.g. a mounted
+	// Kubernetes Secret. It takes precedence over NASAAPIKey and is re-read
+	// when the file changes.
+	NASAAPIKeyFile string `yaml:"nasa_api_key_file"`
+	// UpstreamTimeout bounds every outbound request to an upstream API,
+	// including its retries
+	UpstreamTimeout time.Duration `yaml:"upstream_timeout"`
+	// UpstreamMaxAttempts is the number of attempts made for idempotent
+	// upstream requests; 1 disables retries
+	UpstreamMaxAttempts int `yaml:"upstream_max_attempts"`
+	// UpstreamRetryBaseDelay is the backoff before the first retry, doubled
+	// with every further attempt and jittered
+	UpstreamRetryBaseDelay time.Duration `yaml:"upstream_retry_base_delay"`
+	// UpstreamRetryMaxDelay caps a single backoff, including Retry-After
+	UpstreamRetryMaxDelay time.Duration `yaml:"upstream_retry_max_delay"`
+
+	// BreakerWindow is the rolling period over which each upstream's
+	// failure rate is measured
+	BreakerWindow time.Duration `yaml:"breaker_window"`
+	// BreakerMinRequests is the number of requests needed in the window
+	// before a circuit breaker may open
+	BreakerMinRequests int `yaml:"breaker_min_requests"`
+	// BreakerFailureRate is the failure fraction (0-1] that opens a breaker
+	BreakerFailureRate float64 `yaml:"breaker_failure_rate"`
+	// BreakerCoolDown is how long an open breaker fails fast before probing
+	BreakerCoolDown time.Duration `yaml:"breaker_cool_down"`
+
+	// CacheMaxEntries bounds the number of upstream responses kept in memory
+	CacheMaxEntries int `yaml:"cache_max_entries"`
+	// CacheRocketsTTL is how long rocket, capsule, core, crew, launchpad and
+	// landpad data is served from the cache; 0 disables it
+	CacheRocketsTTL time.Duration `yaml:"cache_rockets_ttl"`
+	// CacheLaunchTTL is how long launch data is served from the cache; 0 disables it
+	CacheLaunchTTL time.Duration `yaml:"cache_launch_ttl"`
+	// CacheMathFactTTL is how long a math fact is served from the cache.
+	// The Numbers API is random by design so this defaults to 0 (disabled).
+	CacheMathFactTTL time.Duration `yaml:"cache_math_fact_ttl"`
+	// CacheAPODTTL is how long NASA's picture of the day is served from the cache
+	CacheAPODTTL time.Duration `yaml:"cache_apod_ttl"`
+	// CacheStaleTTL is how long an expired value may still be served while
+	// it is refreshed in the background
+	CacheStaleTTL time.Duration `yaml:"cache_stale_ttl"`
+
+	// LogLevel is the minimum zerolog level that is written (debug, info, warn, ...)
+	LogLevel string `yaml:"log_level"`
+}
+
+// Default returns the configuration used when nothing else is specified
+func Default() *Config {
+	cfg := &Config{
+		HTTPAddr:            ":8080",
+		HTTPSAddr:           ":8443",
+		GRPCAddr:            ":50053",
+		ShutdownTimeout:     15 * time.Second,
+		LaunchWatchInterval: 30 * time.Second,
+		SpaceXBaseURL:       lib.DefaultSpaceXBaseURL,
+		NumbersBaseURL:      lib.DefaultNumbersBaseURL,
+		NASABaseURL:         lib.DefaultNASABaseURL,
+		UpstreamTimeout:     lib.DefaultTimeout,
+		LogLevel:            "info",
+
+		CacheMaxEntries: 1000,
+		CacheRocketsTTL: time.Hour,
+		CacheLaunchTTL:  time.Minute,
+		CacheAPODTTL:    time.Hour,
+		CacheStaleTTL:   10 * time.Minute,
+	}
+	retry := upstream.DefaultRetryPolicy()
+	cfg.UpstreamMaxAttempts = retry.MaxAttempts
+	cfg.UpstreamRetryBaseDelay = retry.BaseDelay
+	cfg.UpstreamRetryMaxDelay = retry.MaxDelay
+	breaker := upstream.DefaultBreakerSettings()
+	cfg.BreakerWindow = breaker.Window
+	cfg.BreakerMinRequests = breaker.MinRequests
+	cfg.BreakerFailureRate = breaker.FailureRate
+	cfg.BreakerCoolDown = breaker.CoolDown
+	return cfg
+}
+
+// Load resolves the configuration from defaults, the config file, the
+// environment and the given command-line arguments (without the program name)
+func Load(args []string) (*Config, error) {
+	cfg := Default()
+
+	fs := flag.NewFlagSet("outerspace-go", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML or JSON config file (env CONFIG_FILE)")
+
+	// Flags are parsed into a separate struct so that only the ones set
+	// explicitly on the command line override the other sources
+	var flags Config
+	fs.StringVar(&flags.HTTPAddr, "http-addr", "", "HTTP listen address (env PORT)")
+	fs.StringVar(&flags.HTTPSAddr, "https-addr", "", "HTTPS listen address when TLS is enabled (env HTTPS_PORT)")
+	fs.StringVar(&flags.GRPCAddr, "grpc-addr", "", "gRPC listen address (env GRPC_PORT)")
+	fs.StringVar(&flags.GRPCHealthAddr, "grpc-health-addr", "", "plaintext gRPC health check listen address, empty disables (env GRPC_HEALTH_PORT)")
+	fs.BoolVar(&flags.GRPCReflection, "grpc-reflection", false, "register the gRPC server reflection service (env GRPC_REFLECTION)")
+	fs.DurationVar(&flags.LaunchWatchInterval, "launch-watch-interval", 0, "how often WatchLatestLaunch polls SpaceX (env LAUNCH_WATCH_INTERVAL)")
+	fs.DurationVar(&flags.ShutdownTimeout, "shutdown-timeout", 0, "time allowed for in-flight requests to drain on shutdown (env SHUTDOWN_TIMEOUT)")
+	fs.StringVar(&flags.TLSCertFile, "tls-cert-file", "", "PEM certificate for the HTTPS and gRPC listeners (env TLS_CERT_FILE)")
+	fs.StringVar(&flags.TLSKeyFile, "tls-key-file", "", "PEM private key for the HTTPS and gRPC listeners (env TLS_KEY_FILE)")
+	fs.StringVar(&flags.TLSClientCAFile, "tls-client-ca-file", "", "PEM CA bundle to verify client certificates against (env TLS_CLIENT_CA_FILE)")
+	fs.StringVar(&flags.SpaceXBaseURL, "spacex-base-url", "", "SpaceX API base URL (env SPACEX_BASE_URL)")
+	fs.StringVar(&flags.NumbersBaseURL, "numbers-base-url", "", "Numbers API base URL (env NUMBERS_BASE_URL)")
+	fs.StringVar(&flags.NASABaseURL, "nasa-base-url", "", "NASA API base URL (env NASA_BASE_URL)")
+	fs.StringVar(&flags.NASAAPIKeyFile, "nasa-api-key-file", "", "file containing the NASA API key (env NASA_API_KEY_FILE)")
+	fs.DurationVar(&flags.UpstreamTimeout, "upstream-timeout", 0, "timeout for upstream API requests (env UPSTREAM_TIMEOUT)")
+	fs.IntVar(&flags.UpstreamMaxAttempts, "upstream-max-attempts", 0, "attempts for idempotent upstream requests, 1 disables retries (env UPSTREAM_MAX_ATTEMPTS)")
+	fs.DurationVar(&flags.UpstreamRetryBaseDelay, "upstream-retry-base-delay", 0, "backoff before the first upstream retry (env UPSTREAM_RETRY_BASE_DELAY)")
+	fs.DurationVar(&flags.UpstreamRetryMaxDelay, "upstream-retry-max-delay", 0, "maximum backoff between upstream retries (env UPSTREAM_RETRY_MAX_DELAY)")
+	fs.DurationVar(&flags.BreakerWindow, "breaker-window", 0, "window over which upstream failure rates are measured (env BREAKER_WINDOW)")
+	fs.IntVar(&flags.BreakerMinRequests, "breaker-min-requests", 0, "requests needed in the window before a breaker may open (env BREAKER_MIN_REQUESTS)")
+	fs.Float64Var(&flags.BreakerFailureRate, "breaker-failure-rate", 0, "failure fraction that opens a breaker (env BREAKER_FAILURE_RATE)")
+	fs.DurationVar(&flags.BreakerCoolDown, "breaker-cool-down", 0, "how long an open breaker waits before probing (env BREAKER_COOL_DOWN)")
+	fs.IntVar(&flags.CacheMaxEntries, "cache-max-entries", 0, "maximum number of cached upstream responses (env CACHE_MAX_ENTRIES)")
+	fs.DurationVar(&flags.CacheRocketsTTL, "cache-rockets-ttl", 0, "cache TTL for rocket, capsule, core, crew, launchpad and landpad data, 0 disables (env CACHE_ROCKETS_TTL)")
+	fs.DurationVar(&flags.CacheLaunchTTL, "cache-launch-ttl", 0, "cache TTL for launch data, 0 disables (env CACHE_LAUNCH_TTL)")
+	fs.DurationVar(&flags.CacheMathFactTTL, "cache-math-fact-ttl", 0, "cache TTL for math facts, 0 disables (env CACHE_MATH_FACT_TTL)")
+	fs.DurationVar(&flags.CacheAPODTTL, "cache-apod-ttl", 0, "cache TTL for NASA's picture of the day, 0 disables (env CACHE_APOD_TTL)")
+	fs.DurationVar(&flags.CacheStaleTTL, "cache-stale-ttl", 0, "how long expired values are served while refreshing (env CACHE_STALE_TTL)")
+	fs.StringVar(&flags.LogLevel, "log-level", "", "log level: debug, info, warn, error (env LOG_LEVEL)")
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "http-addr":
+			cfg.HTTPAddr = flags.HTTPAddr
+		case "https-addr":
+			cfg.HTTPSAddr = flags.HTTPSAddr
+		case "grpc-addr":
+			cfg.GRPCAddr = flags.GRPCAddr
+		case "grpc-health-addr":
+			cfg.GRPCHealthAddr = flags.GRPCHealthAddr
+		case "grpc-reflection":
+			cfg.GRPCReflection = flags.GRPCReflection
+		case "launch-watch-interval":
+			cfg.LaunchWatchInterval = flags.LaunchWatchInterval
+		case "shutdown-timeout":
+			cfg.ShutdownTimeout = flags.ShutdownTimeout
+		case "tls-cert-file":
+			cfg.TLSCertFile = flags.TLSCertFile
+		case "tls-key-file":
+			cfg.TLSKeyFile = flags.TLSKeyFile
+		case "tls-client-ca-file":
+			cfg.TLSClientCAFile = flags.TLSClientCAFile
+		case "spacex-base-url":
+			cfg.SpaceXBaseURL = flags.SpaceXBaseURL
+		case "numbers-base-url":
+			cfg.NumbersBaseURL = flags.NumbersBaseURL
+		case "nasa-base-url":
+			cfg.NASABaseURL = flags.NASABaseURL
+		case "nasa-api-key-file":
+			cfg.NASAAPIKeyFile = flags.NASAAPIKeyFile
+		case "upstream-timeout":
+			cfg.UpstreamTimeout = flags.UpstreamTimeout
+		case "upstream-max-attempts":
+			cfg.UpstreamMaxAttempts = flags.UpstreamMaxAttempts
+		case "upstream-retry-base-delay":
+			cfg.UpstreamRetryBaseDelay = flags.UpstreamRetryBaseDelay
+		case "upstream-retry-max-delay":
+			cfg.UpstreamRetryMaxDelay = flags.UpstreamRetryMaxDelay
+		case "breaker-window":
+			cfg.BreakerWindow = flags.BreakerWindow
+		case "breaker-min-requests":
+			cfg.BreakerMinRequests = flags.BreakerMinRequests
+		case "breaker-failure-rate":
+			cfg.BreakerFailureRate = flags.BreakerFailureRate
+		case "breaker-cool-down":
+			cfg.BreakerCoolDown = flags.BreakerCoolDown
+		case "cache-max-entries":
+			cfg.CacheMaxEntries = flags.CacheMaxEntries
+		case "cache-rockets-ttl":
+			cfg.CacheRocketsTTL = flags.CacheRocketsTTL
+		case "cache-launch-ttl":
+			cfg.CacheLaunchTTL = flags.CacheLaunchTTL
+		case "cache-math-fact-ttl":
+			cfg.CacheMathFactTTL = flags.CacheMathFactTTL
+		case "cache-apod-ttl":
+			cfg.CacheAPODTTL = flags.CacheAPODTTL
+		case "cache-stale-ttl":
+			cfg.CacheStaleTTL = flags.CacheStaleTTL
+		case "log-level":
+			cfg.LogLevel = flags.LogLevel
+		}
+	})
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// loadFile overlays the settings found in a YAML or JSON file. JSON is a
+// subset of YAML so both formats go through the same decoder
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// loadEnv overlays the settings found in environment variables
+func (c *Config) loadEnv() error {
+	if port := os.Getenv("PORT"); port != "" {
+		c.HTTPAddr = portAddr(port)
+	}
+	if port := os.Getenv("HTTPS_PORT"); port != "" {
+		c.HTTPSAddr = portAddr(port)
+	}
+	if port := os.Getenv("GRPC_PORT"); port != "" {
+		c.GRPCAddr = portAddr(port)
+	}
+	if port := os.Getenv("GRPC_HEALTH_PORT"); port != "" {
+		c.GRPCHealthAddr = portAddr(port)
+	}
+	envString("TLS_CERT_FILE", &c.TLSCertFile)
+	envString("TLS_KEY_FILE", &c.TLSKeyFile)
+	envString("TLS_CLIENT_CA_FILE", &c.TLSClientCAFile)
+	envString("SPACEX_BASE_URL", &c.SpaceXBaseURL)
+	envString("NUMBERS_BASE_URL", &c.NumbersBaseURL)
+	envString("NASA_BASE_URL", &c.NASABaseURL)
+	envString("NASA_API_KEY", &c.NASAAPIKey)
+	envString("NASA_API_KEY_FILE", &c.NASAAPIKeyFile)
+	envString("LOG_LEVEL", &c.LogLevel)
+
+	return errors.Join(
+		envBool("GRPC_REFLECTION", &c.GRPCReflection),
+		envDuration("LAUNCH_WATCH_INTERVAL", &c.LaunchWatchInterval),
+		envDuration("SHUTDOWN_TIMEOUT", &c.ShutdownTimeout),
+		envDuration("UPSTREAM_TIMEOUT", &c.UpstreamTimeout),
+		envInt("UPSTREAM_MAX_ATTEMPTS", &c.UpstreamMaxAttempts),
+		envDuration("UPSTREAM_RETRY_BASE_DELAY", &c.UpstreamRetryBaseDelay),
+		envDuration("UPSTREAM_RETRY_MAX_DELAY", &c.UpstreamRetryMaxDelay),
+		envDuration("BREAKER_WINDOW", &c.BreakerWindow),
+		envInt("BREAKER_MIN_REQUESTS", &c.BreakerMinRequests),
+		envFloat("BREAKER_FAILURE_RATE", &c.BreakerFailureRate),
+		envDuration("BREAKER_COOL_DOWN", &c.BreakerCoolDown),
+		envInt("CACHE_MAX_ENTRIES", &c.CacheMaxEntries),
+		envDuration("CACHE_ROCKETS_TTL", &c.CacheRocketsTTL),
+		envDuration("CACHE_LAUNCH_TTL", &c.CacheLaunchTTL),
+		envDuration("CACHE_MATH_FACT_TTL", &c.CacheMathFactTTL),
+		envDuration("CACHE_APOD_TTL", &c.CacheAPODTTL),
+		envDuration("CACHE_STALE_TTL", &c.CacheStaleTTL),
+	)
+}
+
+// envString sets dst from the named environment variable when it is non-empty
+func envString(name string, dst *string) {
+	if v := os.Getenv(name); v != "" {
+		*dst = v
+	}
+}
+
+// envBool sets dst from the named environment variable when it is non-empty
+func envBool(name string, dst *bool) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	*dst = b
+	return nil
+}
+
+// envDuration sets dst from the named environment variable when it is non-empty
+func envDuration(name string, dst *time.Duration) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	*dst = d
+	return nil
+}
+
+// envInt sets dst from the named environment variable when it is non-empty
+func envInt(name string, dst *int) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	*dst = n
+	return nil
+}
+
+// envFloat sets dst from the named environment variable when it is non-empty
+func envFloat(name string, dst *float64) error {
+	v := os.Getenv(name)
+	if v == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", name, err)
+	}
+	*dst = f
+	return nil
+}
+
+// portAddr turns a bare port such as "8080" into a listen address, leaving
+// values that already contain a host or colon untouched
+func portAddr(port string) string {
+	if strings.Contains(port, ":") {
+		return port
+	}
+	return ":" + port
+}
+
+// TLSEnabled reports whether the HTTPS and gRPC listeners use TLS
+func (c *Config) TLSEnabled() bool {
+	return c.TLSCertFile != "" && c.TLSKeyFile != ""
+}
+
+// RetryPolicy returns the upstream retry policy described by the configuration
+func (c *Config) RetryPolicy() upstream.RetryPolicy {
+	return upstream.RetryPolicy{
+		MaxAttempts: c.UpstreamMaxAttempts,
+		BaseDelay:   c.UpstreamRetryBaseDelay,
+		MaxDelay:    c.UpstreamRetryMaxDelay,
+	}
+}
+
+// BreakerSettings returns the circuit breaker settings described by the configuration
+func (c *Config) BreakerSettings() upstream.BreakerSettings {
+	return upstream.BreakerSettings{
+		Window:      c.BreakerWindow,
+		MinRequests: c.BreakerMinRequests,
+		FailureRate: c.BreakerFailureRate,
+		CoolDown:    c.BreakerCoolDown,
+	}
+}
+
+// Validate checks that the configuration is usable
+func (c *Config) Validate() error {
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return fmt.Errorf("tls_cert_file and tls_key_file must be set together")
+	}
+	if c.TLSClientCAFile != "" && !c.TLSEnabled() {
+		return fmt.Errorf("tls_client_ca_file requires tls_cert_file and tls_key_file")
+	}
+	if c.HTTPAddr == "" && !c.TLSEnabled() {
+		return fmt.Errorf("http_addr must not be empty unless TLS is enabled")
+	}
+	if c.HTTPSAddr == "" && c.TLSEnabled() {
+		return fmt.Errorf("https_addr must not be empty when TLS is enabled")
+	}
+	if c.GRPCAddr == "" {
+		return fmt.Errorf("grpc_addr must not be empty")
+	}
+	if c.LaunchWatchInterval <= 0 {
+		return fmt.Errorf("launch_watch_interval must be positive, got %s", c.LaunchWatchInterval)
+	}
+	if c.ShutdownTimeout <= 0 {
+		return fmt.Errorf("shutdown_timeout must be positive, got %s", c.ShutdownTimeout)
+	}
+	for name, raw := range map[string]string{
+		"spacex_base_url":  c.SpaceXBaseURL,
+		"numbers_base_url": c.NumbersBaseURL,
+		"nasa_base_url":    c.NASABaseURL,
+	} {
+		u, err := url.Parse(raw)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("%s must be an absolute URL, got %q", name, raw)
+		}
+	}
+	if c.UpstreamTimeout <= 0 {
+		return fmt.Errorf("upstream_timeout must be positive, got %s", c.UpstreamTimeout)
+	}
+	if c.UpstreamMaxAttempts < 1 {
+		return fmt.Errorf("upstream_max_attempts must be at least 1, got %d", c.UpstreamMaxAttempts)
+	}
+	if c.UpstreamRetryBaseDelay < 0 || c.UpstreamRetryMaxDelay < c.UpstreamRetryBaseDelay {
+		return fmt.Errorf("upstream retry delays must satisfy 0 <= base (%s) <= max (%s)", c.UpstreamRetryBaseDelay, c.UpstreamRetryMaxDelay)
+	}
+	if c.BreakerWindow <= 0 || c.BreakerCoolDown <= 0 {
+		return fmt.Errorf("breaker_window and breaker_cool_down must be positive")
+	}
+	if c.BreakerMinRequests < 1 {
+		return fmt.Errorf("breaker_min_requests must be at least 1, got %d", c.BreakerMinRequests)
+	}
+	if c.BreakerFailureRate <= 0 || c.BreakerFailureRate > 1 {
+		return fmt.Errorf("breaker_failure_rate must be in (0, 1], got %g", c.BreakerFailureRate)
+	}
+	if c.CacheMaxEntries < 1 {
+		return fmt.Errorf("cache_max_entries must be at least 1, got %d", c.CacheMaxEntries)
+	}
+	for name, ttl := range map[string]time.Duration{
+		"cache_rockets_ttl":   c.CacheRocketsTTL,
+		"cache_launch_ttl":    c.CacheLaunchTTL,
+		"cache_math_fact_ttl": c.CacheMathFactTTL,
+		"cache_apod_ttl":      c.CacheAPODTTL,
+		"cache_stale_ttl":     c.CacheStaleTTL,
+	} {
+		if ttl < 0 {
+			return fmt.Errorf("%s must not be negative, got %s", name, ttl)
+		}
+	}
+	if _, err := zerolog.ParseLevel(c.LogLevel); err != nil || c.LogLevel == "" {
+		return fmt.Errorf("invalid log_level %q", c.LogLevel)
+	}
+	return nil
+}
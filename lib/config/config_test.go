@@ -0,0 +1,304 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"outerspace-go/lib"
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clearEnv blanks every variable Load looks at so the host environment
+// cannot leak into the tests
+func clearEnv(t *testing.T) {
+	for _, name := range []string{
+		"CONFIG_FILE", "PORT", "HTTPS_PORT", "GRPC_PORT", "GRPC_HEALTH_PORT", "GRPC_REFLECTION", "LAUNCH_WATCH_INTERVAL", "SHUTDOWN_TIMEOUT",
+		"TLS_CERT_FILE", "TLS_KEY_FILE", "TLS_CLIENT_CA_FILE", "SPACEX_BASE_URL", "NUMBERS_BASE_URL",
+		"NASA_BASE_URL", "NASA_API_KEY", "NASA_API_KEY_FILE", "UPSTREAM_TIMEOUT", "UPSTREAM_MAX_ATTEMPTS",
+		"UPSTREAM_RETRY_BASE_DELAY", "UPSTREAM_RETRY_MAX_DELAY", "BREAKER_WINDOW",
+		"BREAKER_MIN_REQUESTS", "BREAKER_FAILURE_RATE", "BREAKER_COOL_DOWN", "CACHE_MAX_ENTRIES", "CACHE_ROCKETS_TTL",
+		"CACHE_LAUNCH_TTL", "CACHE_MATH_FACT_TTL", "CACHE_APOD_TTL", "CACHE_STALE_TTL", "LOG_LEVEL",
+	} {
+		t.Setenv(name, "")
+	}
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	clearEnv(t)
+
+	cfg, err := Load(nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, ":8080", cfg.HTTPAddr)
+	assert.Equal(t, ":8443", cfg.HTTPSAddr)
+	assert.Equal(t, ":50053", cfg.GRPCAddr)
+	assert.Empty(t, cfg.GRPCHealthAddr)
+	assert.False(t, cfg.GRPCReflection)
+	assert.False(t, cfg.TLSEnabled())
+	assert.Equal(t, 30*time.Second, cfg.LaunchWatchInterval)
+	assert.Equal(t, 15*time.Second, cfg.ShutdownTimeout)
+	assert.Equal(t, lib.DefaultSpaceXBaseURL, cfg.SpaceXBaseURL)
+	assert.Equal(t, lib.DefaultNumbersBaseURL, cfg.NumbersBaseURL)
+	assert.Equal(t, lib.DefaultNASABaseURL, cfg.NASABaseURL)
+	assert.Equal(t, 10*time.Second, cfg.UpstreamTimeout)
+	assert.Equal(t, upstream.DefaultRetryPolicy(), cfg.RetryPolicy())
+	assert.Equal(t, upstream.DefaultBreakerSettings(), cfg.BreakerSettings())
+	assert.Equal(t, 1000, cfg.CacheMaxEntries)
+	assert.Equal(t, time.Hour, cfg.CacheRocketsTTL)
+	assert.Equal(t, time.Duration(0), cfg.CacheMathFactTTL)
+	assert.Equal(t, "info", cfg.LogLevel)
+}
+
+func TestLoad_Env(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("PORT", "9090")
+	t.Setenv("GRPC_PORT", "127.0.0.1:6000")
+	t.Setenv("GRPC_HEALTH_PORT", "50054")
+	t.Setenv("SPACEX_BASE_URL", "http://localhost:4143/v4")
+	t.Setenv("UPSTREAM_TIMEOUT", "3s")
+	t.Setenv("SHUTDOWN_TIMEOUT", "45s")
+	t.Setenv("LAUNCH_WATCH_INTERVAL", "5s")
+	t.Setenv("LOG_LEVEL", "debug")
+
+	cfg, err := Load(nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, ":9090", cfg.HTTPAddr)
+	assert.Equal(t, "127.0.0.1:6000", cfg.GRPCAddr)
+	assert.Equal(t, ":50054", cfg.GRPCHealthAddr)
+	assert.Equal(t, "http://localhost:4143/v4", cfg.SpaceXBaseURL)
+	assert.Equal(t, 3*time.Second, cfg.UpstreamTimeout)
+	assert.Equal(t, 45*time.Second, cfg.ShutdownTimeout)
+	assert.Equal(t, 5*time.Second, cfg.LaunchWatchInterval)
+	assert.Equal(t, "debug", cfg.LogLevel)
+}
+
+func TestLoad_YAMLFile(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, "config.yaml", `
+http_addr: ":7070"
+numbers_base_url: http://numbers.internal
+upstream_timeout: 2s
+`)
+
+	cfg, err := Load([]string{"-config", path})
+
+	require.NoError(t, err)
+	assert.Equal(t, ":7070", cfg.HTTPAddr)
+	assert.Equal(t, ":50053", cfg.GRPCAddr)
+	assert.Equal(t, "http://numbers.internal", cfg.NumbersBaseURL)
+	assert.Equal(t, 2*time.Second, cfg.UpstreamTimeout)
+}
+
+func TestLoad_JSONFile(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, "config.json", `{"grpc_addr": ":6060", "log_level": "warn"}`)
+	t.Setenv("CONFIG_FILE", path)
+
+	cfg, err := Load(nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, ":6060", cfg.GRPCAddr)
+	assert.Equal(t, "warn", cfg.LogLevel)
+}
+
+func TestLoad_Precedence(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, "config.yaml", `
+http_addr: ":1111"
+grpc_addr: ":2222"
+log_level: error
+`)
+	t.Setenv("PORT", "3333")
+	t.Setenv("LOG_LEVEL", "warn")
+
+	cfg, err := Load([]string{"-config", path, "-log-level", "debug"})
+
+	require.NoError(t, err)
+	// env beats file
+	assert.Equal(t, ":3333", cfg.HTTPAddr)
+	// file beats defaults
+	assert.Equal(t, ":2222", cfg.GRPCAddr)
+	// flags beat env
+	assert.Equal(t, "debug", cfg.LogLevel)
+}
+
+func TestLoad_GRPCReflection(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, "config.yaml", `grpc_reflection: true`)
+
+	cfg, err := Load([]string{"-config", path})
+	require.NoError(t, err)
+	assert.True(t, cfg.GRPCReflection)
+
+	t.Setenv("GRPC_REFLECTION", "false")
+	cfg, err = Load([]string{"-config", path})
+	require.NoError(t, err)
+	assert.False(t, cfg.GRPCReflection)
+
+	cfg, err = Load([]string{"-config", path, "-grpc-reflection"})
+	require.NoError(t, err)
+	assert.True(t, cfg.GRPCReflection)
+}
+
+func TestLoad_GRPCHealthAddr(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, "config.yaml", `grpc_health_addr: ":50054"`)
+
+	cfg, err := Load([]string{"-config", path})
+	require.NoError(t, err)
+	assert.Equal(t, ":50054", cfg.GRPCHealthAddr)
+
+	// An empty flag turns the health listener off again
+	cfg, err = Load([]string{"-config", path, "-grpc-health-addr", ""})
+	require.NoError(t, err)
+	assert.Empty(t, cfg.GRPCHealthAddr)
+}
+
+func TestLoad_TLS(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, "config.yaml", `
+tls_cert_file: /etc/tls/tls.crt
+tls_key_file: /etc/tls/tls.key
+`)
+	t.Setenv("TLS_CLIENT_CA_FILE", "/etc/tls/ca.crt")
+	t.Setenv("HTTPS_PORT", "9443")
+
+	cfg, err := Load([]string{"-config", path, "-http-addr", ""})
+
+	require.NoError(t, err)
+	assert.True(t, cfg.TLSEnabled())
+	assert.Equal(t, "/etc/tls/tls.crt", cfg.TLSCertFile)
+	assert.Equal(t, "/etc/tls/tls.key", cfg.TLSKeyFile)
+	assert.Equal(t, "/etc/tls/ca.crt", cfg.TLSClientCAFile)
+	assert.Equal(t, ":9443", cfg.HTTPSAddr)
+	// The plaintext listener may be turned off once TLS is enabled
+	assert.Empty(t, cfg.HTTPAddr)
+}
+
+func TestLoad_RetryPolicy(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("UPSTREAM_MAX_ATTEMPTS", "5")
+	t.Setenv("UPSTREAM_RETRY_MAX_DELAY", "4s")
+
+	cfg, err := Load([]string{"-upstream-retry-base-delay", "250ms"})
+
+	require.NoError(t, err)
+	assert.Equal(t, upstream.RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   250 * time.Millisecond,
+		MaxDelay:    4 * time.Second,
+	}, cfg.RetryPolicy())
+}
+
+func TestLoad_BreakerSettings(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("BREAKER_FAILURE_RATE", "0.25")
+	path := writeFile(t, "config.yaml", `
+breaker_window: 1m
+breaker_cool_down: 30s
+`)
+
+	cfg, err := Load([]string{"-config", path, "-breaker-min-requests", "20"})
+
+	require.NoError(t, err)
+	assert.Equal(t, upstream.BreakerSettings{
+		Window:      time.Minute,
+		MinRequests: 20,
+		FailureRate: 0.25,
+		CoolDown:    30 * time.Second,
+	}, cfg.BreakerSettings())
+}
+
+func TestLoad_Cache(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("CACHE_ROCKETS_TTL", "24h")
+	t.Setenv("CACHE_MAX_ENTRIES", "50")
+
+	cfg, err := Load([]string{"-cache-launch-ttl", "0s", "-cache-math-fact-ttl", "5s"})
+
+	require.NoError(t, err)
+	assert.Equal(t, 24*time.Hour, cfg.CacheRocketsTTL)
+	assert.Equal(t, 50, cfg.CacheMaxEntries)
+	assert.Equal(t, time.Duration(0), cfg.CacheLaunchTTL)
+	assert.Equal(t, 5*time.Second, cfg.CacheMathFactTTL)
+}
+
+func TestLoad_NASAAPIKey(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("NASA_API_KEY", "from-env")
+	t.Setenv("NASA_API_KEY_FILE", "/var/run/secrets/nasa/api-key")
+
+	cfg, err := Load(nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "from-env", cfg.NASAAPIKey)
+	assert.Equal(t, "/var/run/secrets/nasa/api-key", cfg.NASAAPIKeyFile)
+}
+
+func TestLoad_NASAAPIKeyIgnoredInFile(t *testing.T) {
+	clearEnv(t)
+	path := writeFile(t, "config.yaml", `
+nasa_api_key: leaked
+nasa_api_key_file: /etc/nasa/key
+`)
+
+	cfg, err := Load([]string{"-config", path})
+
+	require.NoError(t, err)
+	assert.Empty(t, cfg.NASAAPIKey)
+	assert.Equal(t, "/etc/nasa/key", cfg.NASAAPIKeyFile)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		env  map[string]string
+	}{
+		{name: "unknown flag", args: []string{"-nope"}},
+		{name: "missing file", args: []string{"-config", "/does/not/exist.yaml"}},
+		{name: "bad timeout env", env: map[string]string{"UPSTREAM_TIMEOUT": "soon"}},
+		{name: "bad reflection env", env: map[string]string{"GRPC_REFLECTION": "sometimes"}},
+		{name: "non-positive timeout", args: []string{"-upstream-timeout", "0s"}},
+		{name: "zero launch watch interval", args: []string{"-launch-watch-interval", "0s"}},
+		{name: "bad shutdown timeout env", env: map[string]string{"SHUTDOWN_TIMEOUT": "-1s"}},
+		{name: "TLS cert without key", args: []string{"-tls-cert-file", "tls.crt"}},
+		{name: "client CA without TLS", env: map[string]string{"TLS_CLIENT_CA_FILE": "ca.crt"}},
+		{name: "no HTTP listener without TLS", args: []string{"-http-addr", ""}},
+		{name: "relative base URL", args: []string{"-spacex-base-url", "/v4"}},
+		{name: "bad max attempts env", env: map[string]string{"UPSTREAM_MAX_ATTEMPTS": "many"}},
+		{name: "zero max attempts", args: []string{"-upstream-max-attempts", "0"}},
+		{name: "base delay above max", args: []string{"-upstream-retry-base-delay", "5s", "-upstream-retry-max-delay", "1s"}},
+		{name: "bad failure rate env", env: map[string]string{"BREAKER_FAILURE_RATE": "half"}},
+		{name: "failure rate above one", args: []string{"-breaker-failure-rate", "1.5"}},
+		{name: "zero breaker min requests", args: []string{"-breaker-min-requests", "0"}},
+		{name: "zero cache size", args: []string{"-cache-max-entries", "0"}},
+		{name: "negative cache ttl", args: []string{"-cache-stale-ttl", "-1m"}},
+		{name: "bad log level", args: []string{"-log-level", "loud"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnv(t)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			_, err := Load(tt.args)
+
+			assert.Error(t, err)
+		})
+	}
+}
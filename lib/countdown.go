@@ -0,0 +1,59 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"outerspace-go/lib/upstream"
+)
+
+// LaunchCountdown is a launch with the time left until it, as of the moment
+// it was computed
+type LaunchCountdown struct {
+	Launch
+	// SecondsUntilLaunch is negative once the launch date has passed. It is
+	// only as precise as the launch's DatePrecision: for a launch only known
+	// to the month it counts down to the placeholder date SpaceX gives.
+	SecondsUntilLaunch int64 `json:"seconds_until_launch"`
+	// TimeUntilLaunch is SecondsUntilLaunch as a duration, e.g. 72h3m10s
+	TimeUntilLaunch string `json:"time_until_launch"`
+}
+
+// NewLaunchCountdown computes the time from now until launch. A launch date
+// that cannot be parsed is reported as a bad SpaceX payload.
+func NewLaunchCountdown(launch Launch, now time.Time) (*LaunchCountdown, error) {
+	date, err := time.Parse(time.RFC3339, launch.DateUTC)
+	if err != nil {
+		return nil, &upstream.Error{
+			Upstream: SpaceXUpstream,
+			Kind:     upstream.ErrBadPayload,
+			Err:      fmt.Errorf("launch %q has an invalid date: %w", launch.ID, err),
+		}
+	}
+	until := date.Sub(now).Truncate(time.Second)
+	return &LaunchCountdown{
+		Launch:             launch,
+		SecondsUntilLaunch: int64(until / time.Second),
+		TimeUntilLaunch:    until.String(),
+	}, nil
+}
+
+// GetNextLaunch fetches the next upcoming SpaceX launch
+func (c *SpaceXClient) GetNextLaunch(ctx context.Context) (*Launch, error) {
+	var launch Launch
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/launches/next", c.baseURL), &launch); err != nil {
+		return nil, err
+	}
+	return &launch, nil
+}
+
+// GetUpcomingLaunches fetches all upcoming SpaceX launches in the order
+// SpaceX lists them, by flight number
+func (c *SpaceXClient) GetUpcomingLaunches(ctx context.Context) ([]Launch, error) {
+	var launches []Launch
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/launches/upcoming", c.baseURL), &launches); err != nil {
+		return nil, err
+	}
+	return launches, nil
+}
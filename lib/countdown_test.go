@@ -0,0 +1,120 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveFixture answers requests for path with a canned SpaceX response from
+// testdata/spacex and returns the base URL of the API. The fixtures are
+// hand-written in the shape of the v4 API, not recorded, see the README there;
+// prefer serveCapture for endpoints the proxymock recordings cover.
+func serveFixture(t *testing.T, path, fixture string) string {
+	body, err := os.ReadFile(filepath.Join("testdata", "spacex", fixture))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, path, r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL + "/v4"
+}
+
+func TestNewLaunchCountdown(t *testing.T) {
+	launch := Launch{ID: "abc", DateUTC: "2022-11-01T13:41:00.000Z", DatePrecision: "hour"}
+
+	countdown, err := NewLaunchCountdown(launch, time.Date(2022, 10, 30, 12, 0, 0, 500, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, launch, countdown.Launch)
+	// Partial seconds are dropped
+	assert.Equal(t, int64(49*3600+40*60+59), countdown.SecondsUntilLaunch)
+	assert.Equal(t, "49h40m59s", countdown.TimeUntilLaunch)
+
+	// Once the date has passed the countdown is negative
+	countdown, err = NewLaunchCountdown(launch, time.Date(2022, 11, 1, 14, 41, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, int64(-3600), countdown.SecondsUntilLaunch)
+	assert.Equal(t, "-1h0m0s", countdown.TimeUntilLaunch)
+}
+
+func TestNewLaunchCountdown_InvalidDate(t *testing.T) {
+	_, err := NewLaunchCountdown(Launch{ID: "abc", DateUTC: "soon"}, time.Now())
+
+	assert.ErrorIs(t, err, upstream.ErrBadPayload)
+}
+
+func TestSpaceXClient_GetLatestLaunch_Recorded(t *testing.T) {
+	client := NewSpaceXClient(WithBaseURL(serveCapture(t, "/v4/launches/latest")))
+
+	launch, err := client.GetLatestLaunch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "62dd70d5202306255024d139", launch.ID)
+	assert.Equal(t, 187, launch.FlightNumber)
+	assert.Equal(t, "Crew-5", launch.MissionName)
+	assert.Equal(t, "2022-10-05T16:00:00.000Z", launch.DateUTC)
+	assert.Equal(t, "hour", launch.DatePrecision)
+	assert.False(t, launch.Upcoming)
+	require.NotNil(t, launch.Success)
+	assert.True(t, *launch.Success)
+
+	countdown, err := NewLaunchCountdown(*launch, time.Date(2022, 10, 5, 17, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, int64(-3600), countdown.SecondsUntilLaunch)
+}
+
+func TestSpaceXClient_GetNextLaunch(t *testing.T) {
+	client := NewSpaceXClient(WithBaseURL(serveFixture(t, "/v4/launches/next", "launches_next.json")))
+
+	launch, err := client.GetNextLaunch(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "5fe3b107b3467846b3242198", launch.ID)
+	assert.Equal(t, 187, launch.FlightNumber)
+	assert.Equal(t, "USSF-44", launch.MissionName)
+	assert.Equal(t, "2022-11-01T13:41:00.000Z", launch.DateUTC)
+	assert.Equal(t, "hour", launch.DatePrecision)
+	assert.True(t, launch.Upcoming)
+	// "success": null reads as unknown rather than failed
+	assert.Nil(t, launch.Success)
+	assert.Equal(t, "5e9d0d95eda69974db09d1ed", launch.RocketID)
+
+	countdown, err := NewLaunchCountdown(*launch, time.Date(2022, 11, 1, 13, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, int64(41*60), countdown.SecondsUntilLaunch)
+}
+
+func TestSpaceXClient_GetUpcomingLaunches(t *testing.T) {
+	client := NewSpaceXClient(WithBaseURL(serveFixture(t, "/v4/launches/upcoming", "launches_upcoming.json")))
+
+	launches, err := client.GetUpcomingLaunches(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, launches, 3)
+	assert.Equal(t, "USSF-44", launches[0].MissionName)
+	assert.Equal(t, "hour", launches[0].DatePrecision)
+	assert.Equal(t, "Starlink 4-36 (v1.5)", launches[1].MissionName)
+	assert.Equal(t, "day", launches[1].DatePrecision)
+	assert.Equal(t, "Crew-6", launches[2].MissionName)
+	assert.Equal(t, "month", launches[2].DatePrecision)
+	for _, launch := range launches {
+		assert.True(t, launch.Upcoming)
+		_, err := NewLaunchCountdown(launch, time.Now())
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,76 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// Capsule is a Dragon capsule and how often it has flown
+type Capsule struct {
+	ID     string `json:"id"`
+	Serial string `json:"serial"`
+	// Status is active, retired, unknown or destroyed
+	Status        string `json:"status"`
+	Type          string `json:"type"`
+	ReuseCount    int    `json:"reuse_count"`
+	WaterLandings int    `json:"water_landings"`
+	LandLandings  int    `json:"land_landings"`
+	LastUpdate    string `json:"last_update"`
+	// Launches are the IDs of the launches the capsule flew on
+	Launches []string `json:"launches"`
+}
+
+// Core is a first stage booster and its landing record
+type Core struct {
+	ID     string `json:"id"`
+	Serial string `json:"serial"`
+	// Block is the Falcon 9 block version, 0 when unknown
+	Block int `json:"block"`
+	// Status is active, inactive, unknown, expended, lost or retired
+	Status       string `json:"status"`
+	ReuseCount   int    `json:"reuse_count"`
+	RTLSAttempts int    `json:"rtls_attempts"`
+	RTLSLandings int    `json:"rtls_landings"`
+	ASDSAttempts int    `json:"asds_attempts"`
+	ASDSLandings int    `json:"asds_landings"`
+	LastUpdate   string `json:"last_update"`
+	// Launches are the IDs of the launches the core flew on
+	Launches []string `json:"launches"`
+}
+
+// GetAllCapsules fetches all Dragon capsules
+func (c *SpaceXClient) GetAllCapsules(ctx context.Context) ([]Capsule, error) {
+	var capsules []Capsule
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/capsules", c.baseURL), &capsules); err != nil {
+		return nil, err
+	}
+	return capsules, nil
+}
+
+// GetCapsule fetches a specific capsule by its ID
+func (c *SpaceXClient) GetCapsule(ctx context.Context, capsuleID string) (*Capsule, error) {
+	var capsule Capsule
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/capsules/%s", c.baseURL, url.PathEscape(capsuleID)), &capsule); err != nil {
+		return nil, err
+	}
+	return &capsule, nil
+}
+
+// GetAllCores fetches all first stage cores
+func (c *SpaceXClient) GetAllCores(ctx context.Context) ([]Core, error) {
+	var cores []Core
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/cores", c.baseURL), &cores); err != nil {
+		return nil, err
+	}
+	return cores, nil
+}
+
+// GetAllCrew fetches all crew members who flew or will fly on SpaceX launches
+func (c *SpaceXClient) GetAllCrew(ctx context.Context) ([]CrewMember, error) {
+	var crew []CrewMember
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/crew", c.baseURL), &crew); err != nil {
+		return nil, err
+	}
+	return crew, nil
+}
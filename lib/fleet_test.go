@@ -0,0 +1,120 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpaceXClient_GetAllCapsules(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/capsules", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"id":"5e9e2c5bf35918ed873b2664","serial":"C101","status":"retired","type":"Dragon 1.0","reuse_count":0,"water_landings":1,"land_landings":0,"last_update":"Reentered after three weeks in orbit","launches":["5eb87cdeffd86e000604b330"]},
+			{"id":"5e9e2c5df359185f973b2675","serial":"C206","status":"active","type":"Dragon 2.0","reuse_count":2,"water_landings":3,"land_landings":0,"last_update":null,"launches":[]}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	capsules, err := client.GetAllCapsules(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, capsules, 2)
+	assert.Equal(t, "C101", capsules[0].Serial)
+	assert.Equal(t, "retired", capsules[0].Status)
+	assert.Equal(t, 1, capsules[0].WaterLandings)
+	assert.Equal(t, []string{"5eb87cdeffd86e000604b330"}, capsules[0].Launches)
+	assert.Equal(t, "Dragon 2.0", capsules[1].Type)
+	assert.Equal(t, 2, capsules[1].ReuseCount)
+	assert.Empty(t, capsules[1].LastUpdate)
+}
+
+func TestSpaceXClient_GetCapsule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/capsules/5e9e2c5df359185f973b2675", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"5e9e2c5df359185f973b2675","serial":"C206","status":"active","type":"Dragon 2.0","reuse_count":2}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	capsule, err := client.GetCapsule(context.Background(), "5e9e2c5df359185f973b2675")
+
+	require.NoError(t, err)
+	assert.Equal(t, "C206", capsule.Serial)
+	assert.Equal(t, "active", capsule.Status)
+}
+
+func TestSpaceXClient_GetCapsule_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	capsule, err := client.GetCapsule(context.Background(), "unknown")
+
+	assert.ErrorIs(t, err, upstream.ErrNotFound)
+	assert.Nil(t, capsule)
+}
+
+func TestSpaceXClient_GetAllCores(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/cores", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"id":"5e9e289df35918033d3b2623","serial":"B1058","block":5,"status":"lost","reuse_count":13,"rtls_attempts":0,"rtls_landings":0,"asds_attempts":14,"asds_landings":14,"last_update":"Lost in transit after landing","launches":["5eb87d46ffd86e000604b388"]},
+			{"id":"5e9e289ef35918416a3b2624","serial":"B0003","block":null,"status":"expended","reuse_count":0,"rtls_attempts":0,"rtls_landings":0,"asds_attempts":0,"asds_landings":0,"last_update":null,"launches":[]}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	cores, err := client.GetAllCores(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, cores, 2)
+	assert.Equal(t, "B1058", cores[0].Serial)
+	assert.Equal(t, 5, cores[0].Block)
+	assert.Equal(t, 13, cores[0].ReuseCount)
+	assert.Equal(t, 14, cores[0].ASDSLandings)
+	assert.Equal(t, 0, cores[1].Block)
+	assert.Equal(t, "expended", cores[1].Status)
+}
+
+func TestSpaceXClient_GetAllCrew(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/crew", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"5ebf1a6e23a9a60006e03a7a","name":"Robert Behnken","agency":"NASA","image":"https://example.com/behnken.png","wikipedia":"https://en.wikipedia.org/wiki/Robert_L._Behnken","status":"active","launches":["5eb87d46ffd86e000604b388"]}]`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	crew, err := client.GetAllCrew(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, crew, 1)
+	assert.Equal(t, "Robert Behnken", crew[0].Name)
+	assert.Equal(t, "NASA", crew[0].Agency)
+	assert.Equal(t, "active", crew[0].Status)
+	assert.Equal(t, []string{"5eb87d46ffd86e000604b388"}, crew[0].Launches)
+}
@@ -2,9 +2,11 @@ package grpc
 
 import (
 	"context"
+	"io"
 	"log"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -14,9 +16,20 @@ type Client struct {
 	client LaunchServiceClient
 }
 
-// NewClient creates a new gRPC client
-func NewClient(serverAddr string) (*Client, error) {
-	conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+// NewClient creates a new gRPC client. It connects in plaintext unless
+// WithClientTLS is given.
+func NewClient(serverAddr string, opts ...ClientOption) (*Client, error) {
+	var o clientOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	creds := insecure.NewCredentials()
+	if o.tls != nil {
+		creds = credentials.NewTLS(o.tls)
+	}
+
+	conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(creds))
 	if err != nil {
 		return nil, err
 	}
@@ -39,24 +52,119 @@ func (c *Client) GetLatestLaunch(ctx context.Context) (*Launch, error) {
 	return c.client.GetLatestLaunch(ctx, req)
 }
 
+// GetNextLaunch calls the GetNextLaunch RPC
+func (c *Client) GetNextLaunch(ctx context.Context) (*LaunchCountdown, error) {
+	req := &GetNextLaunchRequest{}
+	return c.client.GetNextLaunch(ctx, req)
+}
+
+// GetLaunch calls the GetLaunch RPC. With populate the rocket, launchpad,
+// payloads and crew are returned as well as their IDs.
+func (c *Client) GetLaunch(ctx context.Context, id string, populate bool) (*LaunchDetail, error) {
+	req := &GetLaunchRequest{Id: id, Populate: populate}
+	return c.client.GetLaunch(ctx, req)
+}
+
+// ListLaunches calls the ListLaunches RPC. Pass the next_page_token of a
+// response as req.PageToken to get the following page.
+func (c *Client) ListLaunches(ctx context.Context, req *ListLaunchesRequest) (*ListLaunchesResponse, error) {
+	return c.client.ListLaunches(ctx, req)
+}
+
 // GetRocket calls the GetRocket RPC
 func (c *Client) GetRocket(ctx context.Context, id string) (*Rocket, error) {
 	req := &GetRocketRequest{Id: id}
 	return c.client.GetRocket(ctx, req)
 }
 
+// BatchGetRockets calls the BatchGetRockets RPC
+func (c *Client) BatchGetRockets(ctx context.Context, ids []string) (*BatchGetRocketsResponse, error) {
+	req := &BatchGetRocketsRequest{Ids: ids}
+	return c.client.BatchGetRockets(ctx, req)
+}
+
 // GetRockets calls the GetRockets RPC
 func (c *Client) GetRockets(ctx context.Context) (*GetRocketsResponse, error) {
 	req := &GetRocketsRequest{}
 	return c.client.GetRockets(ctx, req)
 }
 
+// GetCapsules calls the GetCapsules RPC
+func (c *Client) GetCapsules(ctx context.Context) (*GetCapsulesResponse, error) {
+	req := &GetCapsulesRequest{}
+	return c.client.GetCapsules(ctx, req)
+}
+
+// GetCapsule calls the GetCapsule RPC
+func (c *Client) GetCapsule(ctx context.Context, id string) (*Capsule, error) {
+	req := &GetCapsuleRequest{Id: id}
+	return c.client.GetCapsule(ctx, req)
+}
+
+// GetCores calls the GetCores RPC
+func (c *Client) GetCores(ctx context.Context) (*GetCoresResponse, error) {
+	req := &GetCoresRequest{}
+	return c.client.GetCores(ctx, req)
+}
+
+// GetCrew calls the GetCrew RPC
+func (c *Client) GetCrew(ctx context.Context) (*GetCrewResponse, error) {
+	req := &GetCrewRequest{}
+	return c.client.GetCrew(ctx, req)
+}
+
+// GetLaunchpads calls the GetLaunchpads RPC
+func (c *Client) GetLaunchpads(ctx context.Context, req *GetLaunchpadsRequest) (*GetLaunchpadsResponse, error) {
+	return c.client.GetLaunchpads(ctx, req)
+}
+
+// GetLaunchpad calls the GetLaunchpad RPC
+func (c *Client) GetLaunchpad(ctx context.Context, id string) (*Launchpad, error) {
+	req := &GetLaunchpadRequest{Id: id}
+	return c.client.GetLaunchpad(ctx, req)
+}
+
+// GetLandpads calls the GetLandpads RPC
+func (c *Client) GetLandpads(ctx context.Context) (*GetLandpadsResponse, error) {
+	req := &GetLandpadsRequest{}
+	return c.client.GetLandpads(ctx, req)
+}
+
 // GetMathFact calls the GetMathFact RPC
 func (c *Client) GetMathFact(ctx context.Context) (*MathFact, error) {
 	req := &GetMathFactRequest{}
 	return c.client.GetMathFact(ctx, req)
 }
 
+// GetAPOD calls the GetAPOD RPC. An empty date asks for today's picture.
+func (c *Client) GetAPOD(ctx context.Context, date string) (*APOD, error) {
+	req := &GetAPODRequest{Date: date}
+	return c.client.GetAPOD(ctx, req)
+}
+
+// WatchLatestLaunch calls the WatchLatestLaunch RPC and passes every launch
+// the server sends to onLaunch until the stream ends or ctx is cancelled.
+// It returns nil when the server ends the stream.
+func (c *Client) WatchLatestLaunch(ctx context.Context, onLaunch func(*Launch)) error {
+	stream, err := c.client.WatchLatestLaunch(ctx, &WatchLatestLaunchRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		launch, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		onLaunch(launch)
+	}
+}
+
 // Example usage:
 func Example() {
 	// Create a new client
@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"outerspace-go/lib/upstream"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// UpstreamHealthService returns the name under which the health of the named
+// upstream is reported by the grpc.health.v1 Health service, e.g.
+// "upstream.spacex"
+func UpstreamHealthService(name string) string {
+	return "upstream." + name
+}
+
+// NewHealth returns a grpc.health.v1 Health service. The server as a whole
+// ("") and the LaunchService report SERVING; each upstream reports SERVING
+// while its circuit breaker is closed and NOT_SERVING otherwise, updated on
+// every state change of the breaker.
+func NewHealth(breakers ...*upstream.Breaker) *health.Server {
+	h := health.NewServer()
+	h.SetServingStatus(LaunchService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	for _, breaker := range breakers {
+		service := UpstreamHealthService(breaker.Name())
+		h.SetServingStatus(service, servingStatus(breaker.State()))
+		breaker.OnStateChange(func(from, to upstream.State) {
+			h.SetServingStatus(service, servingStatus(to))
+		})
+	}
+	return h
+}
+
+// NewHealthServer returns a plaintext gRPC server that only serves h. It is
+// meant for a separate port that probes which cannot speak TLS, such as the
+// kubelet's gRPC probes, can reach when the main gRPC server requires TLS or
+// client certificates.
+func NewHealthServer(h *health.Server) *grpc.Server {
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, h)
+	return s
+}
+
+// servingStatus maps a circuit breaker state to a health status
+func servingStatus(state upstream.State) healthpb.HealthCheckResponse_ServingStatus {
+	if state == upstream.StateClosed {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthStatus asks the Health service for the status of service
+func healthStatus(t *testing.T, client healthpb.HealthClient, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func TestHealth_FollowsBreakers(t *testing.T) {
+	breaker := upstream.NewBreaker("spacex", upstream.BreakerSettings{
+		Window:      time.Minute,
+		MinRequests: 1,
+		FailureRate: 0.5,
+		CoolDown:    time.Millisecond,
+	})
+	conn := dialConn(t, New(new(MockSpaceXClient), new(MockNumbersClient), new(MockNASAClient), WithHealth(NewHealth(breaker))))
+	client := healthpb.NewHealthClient(conn)
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, client, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, client, "space.LaunchService"))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, client, "upstream.spacex"))
+
+	// A failure trips the breaker
+	done, err := breaker.Allow()
+	require.NoError(t, err)
+	done(upstream.OutcomeFailure)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, healthStatus(t, client, "upstream.spacex"))
+	// The server itself keeps serving
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, client, ""))
+
+	// A successful probe after the cool-down closes it again
+	time.Sleep(2 * time.Millisecond)
+	done, err = breaker.Allow()
+	require.NoError(t, err)
+	done(upstream.OutcomeSuccess)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, client, "upstream.spacex"))
+}
+
+func TestHealth_NotRegisteredByDefault(t *testing.T) {
+	conn := dialConn(t, New(new(MockSpaceXClient), new(MockNumbersClient), new(MockNASAClient)))
+
+	_, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	assert.Error(t, err)
+}
+
+func TestNewHealthServer(t *testing.T) {
+	breaker := upstream.NewBreaker("spacex", upstream.BreakerSettings{
+		Window:      time.Minute,
+		MinRequests: 1,
+		FailureRate: 0.5,
+		CoolDown:    time.Minute,
+	})
+	h := NewHealth(breaker)
+	// The main server and the health-only server share the same statuses
+	mainConn := dialConn(t, New(new(MockSpaceXClient), new(MockNumbersClient), new(MockNASAClient), WithHealth(h)))
+	healthConn := dialConn(t, NewHealthServer(h))
+	client := healthpb.NewHealthClient(healthConn)
+
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, client, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, healthStatus(t, client, "space.LaunchService"))
+
+	done, err := breaker.Allow()
+	require.NoError(t, err)
+	done(upstream.OutcomeFailure)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, healthStatus(t, client, "upstream.spacex"))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, healthStatus(t, healthpb.NewHealthClient(mainConn), "upstream.spacex"))
+
+	// Only the Health service is served
+	_, err = NewLaunchServiceClient(healthConn).GetMathFact(context.Background(), &GetMathFactRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestReflection(t *testing.T) {
+	conn := dialConn(t, New(new(MockSpaceXClient), new(MockNumbersClient), new(MockNASAClient), WithReflection()))
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+
+	var services []string
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		services = append(services, svc.Name)
+	}
+	assert.Contains(t, services, "space.LaunchService")
+}
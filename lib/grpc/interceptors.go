@@ -0,0 +1,174 @@
+package grpc
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"outerspace-go/lib/requestid"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDKey is the metadata key carrying the request id, the lower-cased
+// form of the X-Request-Id HTTP header
+var requestIDKey = strings.ToLower(requestid.Header)
+
+// interceptors returns the unary and stream interceptors that run, in order,
+// around every RPC: the request id is attached first so that the log line
+// carries it, and panics are recovered innermost so that the log line and
+// the metrics show codes.Internal
+func interceptors(o *serverOptions) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	unary := []grpc.UnaryServerInterceptor{requestIDUnary, loggingUnary}
+	stream := []grpc.StreamServerInterceptor{requestIDStream, loggingStream}
+	if len(o.metrics) > 0 {
+		unary = append(unary, metricsUnary(o.metrics))
+		stream = append(stream, metricsStream(o.metrics))
+	}
+	return append(unary, recoveryUnary), append(stream, recoveryStream)
+}
+
+// wrappedStream replaces the context of a grpc.ServerStream
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *wrappedStream) Context() context.Context {
+	return s.ctx
+}
+
+// withRequestID takes the caller's x-request-id from the incoming metadata,
+// or a new one, and returns it along with a context carrying it
+func withRequestID(ctx context.Context) (context.Context, string) {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDKey); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	id = requestid.FromCaller(id)
+	return requestid.NewContext(ctx, id), id
+}
+
+// requestIDUnary tags every unary call with a request id that is echoed back
+// in the response header metadata
+func requestIDUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, id := withRequestID(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+	return handler(ctx, req)
+}
+
+// requestIDStream tags every stream with a request id that is echoed back in
+// the response header metadata
+func requestIDStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, id := withRequestID(ss.Context())
+	ss.SetHeader(metadata.Pairs(requestIDKey, id))
+	return handler(srv, &wrappedStream{ServerStream: ss, ctx: ctx})
+}
+
+// logCall writes the "Inbound" line for a finished call, matching
+// lib.LoggingMiddleware. Health checks are frequent probes so they are only
+// logged at debug level.
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	addr := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	event := log.Info()
+	if strings.HasPrefix(method, "/grpc.health.v1.Health/") {
+		event = log.Debug()
+	}
+	event.
+		Str("request_id", requestid.FromContext(ctx)).
+		Str("method", method).
+		Str("peer", addr).
+		Str("code", status.Code(err).String()).
+		Dur("latency", time.Since(start)).
+		Msg("Inbound")
+}
+
+// loggingUnary logs every unary call once it has completed
+func loggingUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logCall(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+// loggingStream logs every stream once it has completed
+func loggingStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	logCall(ss.Context(), info.FullMethod, start, err)
+	return err
+}
+
+// metricsUnary reports the method, status code and latency of every unary
+// call to each of observers once it has completed
+func metricsUnary(observers []func(method string, code codes.Code, latency time.Duration)) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observe(observers, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// metricsStream reports the method, status code and duration of every stream
+// to each of observers once it has completed
+func metricsStream(observers []func(method string, code codes.Code, latency time.Duration)) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		observe(observers, info.FullMethod, start, err)
+		return err
+	}
+}
+
+// observe calls every observer with the outcome of a finished call
+func observe(observers []func(method string, code codes.Code, latency time.Duration), method string, start time.Time, err error) {
+	code, latency := status.Code(err), time.Since(start)
+	for _, fn := range observers {
+		fn(method, code, latency)
+	}
+}
+
+// recovered logs a recovered panic and turns it into a codes.Internal error
+func recovered(ctx context.Context, method string, p any) error {
+	log.Error().
+		Str("request_id", requestid.FromContext(ctx)).
+		Str("method", method).
+		Interface("panic", p).
+		Bytes("stack", debug.Stack()).
+		Msg("Recovered from panic")
+	return status.Error(codes.Internal, "internal error")
+}
+
+// recoveryUnary turns a panicking unary handler into a codes.Internal error
+// instead of crashing the server
+func recoveryUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = recovered(ctx, info.FullMethod, p)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// recoveryStream turns a panicking stream handler into a codes.Internal
+// error instead of crashing the server
+func recoveryStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = recovered(ss.Context(), info.FullMethod, p)
+		}
+	}()
+	return handler(srv, ss)
+}
@@ -0,0 +1,191 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"outerspace-go/lib"
+	"outerspace-go/lib/requestid"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// captureLogs redirects the global logger into a buffer for the test
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	orig := log.Logger
+	log.Logger = zerolog.New(&buf)
+	t.Cleanup(func() { log.Logger = orig })
+	return &buf
+}
+
+// logLines decodes the JSON log lines written to buf
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var lines []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var line map[string]any
+		require.NoError(t, dec.Decode(&line))
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestInterceptors_RequestID(t *testing.T) {
+	ts := newTestServer(t)
+	ts.numbers.On("GetMathFact", mock.MatchedBy(func(ctx context.Context) bool {
+		return requestid.FromContext(ctx) == "req-42"
+	})).Return(&lib.MathFact{Number: 42}, nil)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "req-42")
+	var header metadata.MD
+	_, err := ts.client.GetMathFact(ctx, &GetMathFactRequest{}, grpc.Header(&header))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"req-42"}, header.Get("x-request-id"))
+}
+
+func TestInterceptors_GeneratesRequestID(t *testing.T) {
+	ts := newTestServer(t)
+	ts.numbers.On("GetMathFact", mock.Anything).Return(&lib.MathFact{Number: 42}, nil)
+
+	var header metadata.MD
+	_, err := ts.client.GetMathFact(context.Background(), &GetMathFactRequest{}, grpc.Header(&header))
+
+	require.NoError(t, err)
+	require.Len(t, header.Get("x-request-id"), 1)
+	assert.Len(t, header.Get("x-request-id")[0], 32)
+}
+
+func TestInterceptors_StreamRequestID(t *testing.T) {
+	conn := dialConn(t, New(new(MockSpaceXClient), new(MockNumbersClient), new(MockNASAClient), WithHealth(NewHealth())))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "watch-1")
+	stream, err := healthpb.NewHealthClient(conn).Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	header, err := stream.Header()
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"watch-1"}, header.Get("x-request-id"))
+}
+
+func TestInterceptors_Logging(t *testing.T) {
+	logs := captureLogs(t)
+	ts := newTestServer(t)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "req-7")
+	_, err := ts.client.GetRocket(ctx, &GetRocketRequest{})
+	require.Error(t, err)
+
+	lines := logLines(t, logs)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "Inbound", lines[0]["message"])
+	assert.Equal(t, "info", lines[0]["level"])
+	assert.Equal(t, "req-7", lines[0]["request_id"])
+	assert.Equal(t, "/space.LaunchService/GetRocket", lines[0]["method"])
+	assert.Equal(t, "InvalidArgument", lines[0]["code"])
+	assert.Equal(t, "bufconn", lines[0]["peer"])
+	assert.Contains(t, lines[0], "latency")
+}
+
+func TestInterceptors_RecoversPanic(t *testing.T) {
+	logs := captureLogs(t)
+	ts := newTestServer(t)
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Run(func(mock.Arguments) {
+		panic("boom")
+	})
+
+	_, err := ts.client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, err.Error(), "boom")
+
+	// The server keeps serving after the panic
+	ts.numbers.On("GetMathFact", mock.Anything).Return(&lib.MathFact{Number: 42}, nil)
+	_, err = ts.client.GetMathFact(context.Background(), &GetMathFactRequest{})
+	assert.NoError(t, err)
+
+	lines := logLines(t, logs)
+	require.GreaterOrEqual(t, len(lines), 2)
+	assert.Equal(t, "Recovered from panic", lines[0]["message"])
+	assert.Equal(t, "boom", lines[0]["panic"])
+	assert.Equal(t, "Internal", lines[1]["code"])
+}
+
+// recordedCall is what a WithMetrics hook was told about one RPC
+type recordedCall struct {
+	method string
+	code   codes.Code
+}
+
+// recordMetrics returns a WithMetrics option and a function returning the
+// calls it observed so far
+func recordMetrics(t *testing.T) (ServerOption, func() []recordedCall) {
+	var mu sync.Mutex
+	var calls []recordedCall
+	opt := WithMetrics(func(method string, code codes.Code, latency time.Duration) {
+		assert.Positive(t, latency)
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, recordedCall{method: method, code: code})
+	})
+	return opt, func() []recordedCall {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(calls)
+	}
+}
+
+func TestInterceptors_Metrics(t *testing.T) {
+	metrics, calls := recordMetrics(t)
+	ts := newTestServer(t, metrics)
+	ts.numbers.On("GetMathFact", mock.Anything).Return(&lib.MathFact{Number: 42}, nil)
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Run(func(mock.Arguments) {
+		panic("boom")
+	})
+	captureLogs(t)
+
+	_, err := ts.client.GetMathFact(context.Background(), &GetMathFactRequest{})
+	require.NoError(t, err)
+	_, err = ts.client.GetRocket(context.Background(), &GetRocketRequest{})
+	require.Error(t, err)
+	_, err = ts.client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+	require.Error(t, err)
+
+	assert.Equal(t, []recordedCall{
+		{method: "/space.LaunchService/GetMathFact", code: codes.OK},
+		{method: "/space.LaunchService/GetRocket", code: codes.InvalidArgument},
+		// Recovered panics are counted as Internal
+		{method: "/space.LaunchService/GetLatestLaunch", code: codes.Internal},
+	}, calls())
+}
+
+func TestInterceptors_StreamMetrics(t *testing.T) {
+	metrics, calls := recordMetrics(t)
+	conn := dialConn(t, New(new(MockSpaceXClient), new(MockNumbersClient), new(MockNASAClient), WithHealth(NewHealth()), metrics))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := healthpb.NewHealthClient(conn).Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+	cancel()
+
+	// The stream is reported once its handler has returned
+	require.Eventually(t, func() bool { return len(calls()) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, recordedCall{method: "/grpc.health.v1.Health/Watch", code: codes.Canceled}, calls()[0])
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"expvar"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Metrics counts RPCs by method and status code and adds up their latency
+// by method. Pass its Observe method to WithMetrics. It is an expvar.Var, so
+// publishing it with expvar.Publish serves it as JSON from expvar.Handler:
+//
+//	{"calls": {"/space.LaunchService/GetRocket": {"OK": 12, "NotFound": 1}},
+//	 "latency_seconds": {"/space.LaunchService/GetRocket": 0.84}}
+type Metrics struct {
+	vars    expvar.Map
+	calls   expvar.Map
+	latency expvar.Map
+	// mu guards the creation of the per-method maps in calls
+	mu sync.Mutex
+}
+
+// NewMetrics returns empty gRPC metrics
+func NewMetrics() *Metrics {
+	m := &Metrics{}
+	m.vars.Set("calls", &m.calls)
+	m.vars.Set("latency_seconds", &m.latency)
+	return m
+}
+
+// Observe records one finished RPC
+func (m *Metrics) Observe(method string, code codes.Code, latency time.Duration) {
+	m.codes(method).Add(code.String(), 1)
+	m.latency.AddFloat(method, latency.Seconds())
+}
+
+// codes returns the call counts by status code of method
+func (m *Metrics) codes(method string) *expvar.Map {
+	if v, ok := m.calls.Get(method).(*expvar.Map); ok {
+		return v
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if v, ok := m.calls.Get(method).(*expvar.Map); ok {
+		return v
+	}
+	v := new(expvar.Map)
+	m.calls.Set(method, v)
+	return v
+}
+
+// String implements expvar.Var
+func (m *Metrics) String() string {
+	return m.vars.String()
+}
@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"outerspace-go/lib"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// metricsVars is the JSON form of Metrics
+type metricsVars struct {
+	Calls          map[string]map[string]int `json:"calls"`
+	LatencySeconds map[string]float64        `json:"latency_seconds"`
+}
+
+func TestMetrics_Observe(t *testing.T) {
+	m := NewMetrics()
+	m.Observe("/space.LaunchService/GetRocket", codes.OK, 100*time.Millisecond)
+	m.Observe("/space.LaunchService/GetRocket", codes.OK, 200*time.Millisecond)
+	m.Observe("/space.LaunchService/GetRocket", codes.NotFound, 50*time.Millisecond)
+
+	var vars metricsVars
+	require.NoError(t, json.Unmarshal([]byte(m.String()), &vars))
+	assert.Equal(t, map[string]int{"OK": 2, "NotFound": 1}, vars.Calls["/space.LaunchService/GetRocket"])
+	assert.InDelta(t, 0.35, vars.LatencySeconds["/space.LaunchService/GetRocket"], 1e-9)
+}
+
+func TestMetrics_ServedByExpvar(t *testing.T) {
+	// Published names cannot be reused, also not by go test -count
+	name := fmt.Sprintf("grpc_test_%d", time.Now().UnixNano())
+	metrics := NewMetrics()
+	expvar.Publish(name, metrics)
+
+	ts := newTestServer(t, WithMetrics(metrics.Observe))
+	ts.numbers.On("GetMathFact", mock.Anything).Return(&lib.MathFact{Number: 42}, nil)
+	_, err := ts.client.GetMathFact(context.Background(), &GetMathFactRequest{})
+	require.NoError(t, err)
+	_, err = ts.client.GetRocket(context.Background(), &GetRocketRequest{})
+	require.Error(t, err)
+
+	server := httptest.NewServer(expvar.Handler())
+	defer server.Close()
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var published map[string]json.RawMessage
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&published))
+	var vars metricsVars
+	require.NoError(t, json.Unmarshal(published[name], &vars))
+	assert.Equal(t, map[string]int{"OK": 1}, vars.Calls["/space.LaunchService/GetMathFact"])
+	assert.Equal(t, map[string]int{"InvalidArgument": 1}, vars.Calls["/space.LaunchService/GetRocket"])
+	assert.Positive(t, vars.LatencySeconds["/space.LaunchService/GetMathFact"])
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"crypto/tls"
+	"time"
+
+	"outerspace-go/lib"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+)
+
+// ServerOption configures the gRPC server built by New
+type ServerOption func(*serverOptions)
+
+// serverOptions holds the optional services and settings of the gRPC server
+type serverOptions struct {
+	health        *health.Server
+	reflection    bool
+	watchInterval time.Duration
+	watchClient   lib.SpaceXClientInterface
+	tls           *tls.Config
+	metrics       []func(method string, code codes.Code, latency time.Duration)
+	shutdown      <-chan struct{}
+}
+
+// WithHealth registers the grpc.health.v1 Health service, see NewHealth
+func WithHealth(h *health.Server) ServerOption {
+	return func(o *serverOptions) {
+		o.health = h
+	}
+}
+
+// WithReflection registers the server reflection service so that tools such
+// as grpcurl can discover the API
+func WithReflection() ServerOption {
+	return func(o *serverOptions) {
+		o.reflection = true
+	}
+}
+
+// WithWatchInterval sets how often WatchLatestLaunch polls SpaceX, instead of
+// DefaultWatchInterval
+func WithWatchInterval(interval time.Duration) ServerOption {
+	return func(o *serverOptions) {
+		o.watchInterval = interval
+	}
+}
+
+// WithWatchClient sets the SpaceX client WatchLatestLaunch polls, instead of
+// the one the unary RPCs use. Pass an uncached client when the others are
+// served from a cache, or changes only show up once the cached launch
+// expires.
+func WithWatchClient(client lib.SpaceXClientInterface) ServerOption {
+	return func(o *serverOptions) {
+		o.watchClient = client
+	}
+}
+
+// WithShutdown ends open WatchLatestLaunch streams with codes.Unavailable once
+// done is closed. Streams otherwise only end when the client goes away, so
+// close done before GracefulStop or it waits for every watching client.
+func WithShutdown(done <-chan struct{}) ServerOption {
+	return func(o *serverOptions) {
+		o.shutdown = done
+	}
+}
+
+// WithTLS serves the API over TLS instead of plaintext, see tlsconfig.Server
+func WithTLS(config *tls.Config) ServerOption {
+	return func(o *serverOptions) {
+		o.tls = config
+	}
+}
+
+// WithMetrics registers fn to be called after every RPC, unary or streaming,
+// with its full method name, status code and latency, e.g. to feed a request
+// counter and latency histogram. Panics recovered by the server are reported
+// as codes.Internal. fn is called on the RPC's goroutine, so it must be safe
+// for concurrent use and should return quickly.
+func WithMetrics(fn func(method string, code codes.Code, latency time.Duration)) ServerOption {
+	return func(o *serverOptions) {
+		o.metrics = append(o.metrics, fn)
+	}
+}
+
+// ClientOption configures the client built by NewClient
+type ClientOption func(*clientOptions)
+
+// clientOptions holds the optional settings of the gRPC client
+type clientOptions struct {
+	tls *tls.Config
+}
+
+// WithClientTLS connects to the server over TLS instead of plaintext, see
+// tlsconfig.Client
+func WithClientTLS(config *tls.Config) ClientOption {
+	return func(o *clientOptions) {
+		o.tls = config
+	}
+}
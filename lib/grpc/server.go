@@ -2,66 +2,400 @@ package grpc
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"log"
 	"net"
+	"strconv"
+	"time"
 
 	"outerspace-go/lib"
+	"outerspace-go/lib/requestid"
+
+	zlog "github.com/rs/zerolog/log"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
+// DefaultWatchInterval is how often WatchLatestLaunch polls SpaceX by default
+const DefaultWatchInterval = 30 * time.Second
+
 // Server implements the LaunchService
 type Server struct {
 	UnimplementedLaunchServiceServer
-	spaceClient   *lib.SpaceXClient
-	numbersClient *lib.NumbersClient
+	spaceClient   lib.SpaceXClientInterface
+	numbersClient lib.NumbersClientInterface
+	nasaClient    lib.NASAClientInterface
+	// watchClient is polled by WatchLatestLaunch; spaceClient unless set
+	// through WithWatchClient
+	watchClient   lib.SpaceXClientInterface
+	watchInterval time.Duration
+	// shutdown, once closed, ends open WatchLatestLaunch streams; nil never
+	// fires
+	shutdown <-chan struct{}
 }
 
 // NewServer creates a new gRPC server
-func NewServer(spaceClient *lib.SpaceXClient, numbersClient *lib.NumbersClient) *Server {
+func NewServer(spaceClient lib.SpaceXClientInterface, numbersClient lib.NumbersClientInterface, nasaClient lib.NASAClientInterface) *Server {
 	return &Server{
 		spaceClient:   spaceClient,
 		numbersClient: numbersClient,
+		nasaClient:    nasaClient,
+		watchClient:   spaceClient,
+		watchInterval: DefaultWatchInterval,
 	}
 }
 
 // GetLatestLaunch implements the LaunchService interface
 func (s *Server) GetLatestLaunch(ctx context.Context, req *LatestLaunchRequest) (*Launch, error) {
-	launch, err := s.spaceClient.GetLatestLaunch()
+	launch, err := s.spaceClient.GetLatestLaunch(ctx)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
+	}
+
+	return toLaunch(launch), nil
+}
+
+// WatchLatestLaunch implements the LaunchService interface. It polls the
+// watch client every watch interval and sends the latest launch first and
+// then whenever its flight number or success changes, until the caller goes
+// away or the server shuts down, which ends the stream with
+// codes.Unavailable. Failed polls are logged and retried on the next tick.
+// Behind a cache a change is only seen once the cached launch expires,
+// whatever the interval, so the watch client should not be cached.
+func (s *Server) WatchLatestLaunch(req *WatchLatestLaunchRequest, stream grpc.ServerStreamingServer[Launch]) error {
+	ctx := stream.Context()
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	var last *lib.Launch
+	for {
+		launch, err := s.watchClient.GetLatestLaunch(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return status.FromContextError(ctx.Err()).Err()
+		case err != nil:
+			zlog.Warn().
+				Err(err).
+				Str("request_id", requestid.FromContext(ctx)).
+				Msg("Polling latest launch failed")
+		case last == nil || launch.FlightNumber != last.FlightNumber || !equalSuccess(launch.Success, last.Success):
+			if err := stream.Send(toLaunch(launch)); err != nil {
+				return err
+			}
+			last = launch
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-s.shutdown:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-ticker.C:
+		}
 	}
+}
 
+// equalSuccess reports whether two launch outcomes are the same, treating
+// two unknown outcomes as equal
+func equalSuccess(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// toLaunch converts a launch to its protobuf message
+func toLaunch(launch *lib.Launch) *Launch {
 	return &Launch{
-		FlightNumber: int32(launch.FlightNumber),
-		MissionName:  launch.MissionName,
-		DateUtc:      launch.DateUTC,
-		Success:      launch.Success,
-		Details:      launch.Details,
+		FlightNumber:  int32(launch.FlightNumber),
+		MissionName:   launch.MissionName,
+		DateUtc:       launch.DateUTC,
+		Success:       launch.Success,
+		Details:       launch.Details,
+		Id:            launch.ID,
+		RocketId:      launch.RocketID,
+		Upcoming:      launch.Upcoming,
+		DatePrecision: launch.DatePrecision,
+	}
+}
+
+// GetNextLaunch implements the LaunchService interface
+func (s *Server) GetNextLaunch(ctx context.Context, req *GetNextLaunchRequest) (*LaunchCountdown, error) {
+	launch, err := s.spaceClient.GetNextLaunch(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	countdown, err := lib.NewLaunchCountdown(*launch, time.Now())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &LaunchCountdown{
+		Launch:             toLaunch(launch),
+		SecondsUntilLaunch: countdown.SecondsUntilLaunch,
 	}, nil
 }
 
+// GetLaunch implements the LaunchService interface
+func (s *Server) GetLaunch(ctx context.Context, req *GetLaunchRequest) (*LaunchDetail, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "launch id is required")
+	}
+
+	launch, err := s.spaceClient.GetLaunch(ctx, req.Id, req.Populate)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toLaunchDetail(launch), nil
+}
+
+// toLaunchDetail converts all details of a launch to their protobuf message
+func toLaunchDetail(launch *lib.LaunchDetail) *LaunchDetail {
+	detail := &LaunchDetail{
+		Id:            launch.ID,
+		FlightNumber:  int32(launch.FlightNumber),
+		MissionName:   launch.MissionName,
+		DateUtc:       launch.DateUTC,
+		DatePrecision: launch.DatePrecision,
+		Success:       launch.Success,
+		Upcoming:      launch.Upcoming,
+		Details:       launch.Details,
+		Links: &LaunchLinks{
+			PatchSmall: launch.Links.Patch.Small,
+			PatchLarge: launch.Links.Patch.Large,
+			Webcast:    launch.Links.Webcast,
+			YoutubeId:  launch.Links.YouTubeID,
+			Article:    launch.Links.Article,
+			Wikipedia:  launch.Links.Wikipedia,
+			Presskit:   launch.Links.Presskit,
+		},
+		Failures:    make([]*LaunchFailure, len(launch.Failures)),
+		Cores:       make([]*LaunchCore, len(launch.Cores)),
+		RocketId:    launch.Rocket.ID,
+		LaunchpadId: launch.Launchpad.ID,
+		PayloadIds:  make([]string, len(launch.Payloads)),
+		CrewIds:     make([]string, len(launch.Crew)),
+	}
+	for i, failure := range launch.Failures {
+		detail.Failures[i] = &LaunchFailure{
+			Time:     int32(failure.Time),
+			Altitude: int32(failure.Altitude),
+			Reason:   failure.Reason,
+		}
+	}
+	for i, core := range launch.Cores {
+		detail.Cores[i] = &LaunchCore{
+			CoreId:         core.CoreID,
+			Flight:         int32(core.Flight),
+			Gridfins:       core.Gridfins,
+			Legs:           core.Legs,
+			Reused:         core.Reused,
+			LandingAttempt: core.LandingAttempt,
+			LandingSuccess: core.LandingSuccess,
+			LandingType:    core.LandingType,
+			LandpadId:      core.LandpadID,
+		}
+	}
+
+	if launch.Rocket.Value != nil {
+		detail.Rocket = toRocket(launch.Rocket.Value)
+	}
+	if launch.Launchpad.Value != nil {
+		detail.Launchpad = toLaunchpad(launch.Launchpad.Value)
+	}
+	for i, payload := range launch.Payloads {
+		detail.PayloadIds[i] = payload.ID
+		if payload.Value != nil {
+			detail.Payloads = append(detail.Payloads, toPayload(payload.Value))
+		}
+	}
+	for i, member := range launch.Crew {
+		detail.CrewIds[i] = member.ID
+		if member.Value != nil {
+			detail.Crew = append(detail.Crew, toCrewMember(member.Value))
+		}
+	}
+	return detail
+}
+
+// toLaunchpad converts a launchpad to its protobuf message
+func toLaunchpad(launchpad *lib.Launchpad) *Launchpad {
+	return &Launchpad{
+		Id:              launchpad.ID,
+		Name:            launchpad.Name,
+		FullName:        launchpad.FullName,
+		Locality:        launchpad.Locality,
+		Region:          launchpad.Region,
+		Latitude:        launchpad.Latitude,
+		Longitude:       launchpad.Longitude,
+		Status:          launchpad.Status,
+		LaunchAttempts:  int32(launchpad.LaunchAttempts),
+		LaunchSuccesses: int32(launchpad.LaunchSuccesses),
+	}
+}
+
+// toPayload converts a payload to its protobuf message
+func toPayload(payload *lib.Payload) *Payload {
+	return &Payload{
+		Id:        payload.ID,
+		Name:      payload.Name,
+		Type:      payload.Type,
+		Reused:    payload.Reused,
+		Customers: payload.Customers,
+		Orbit:     payload.Orbit,
+		MassKg:    payload.MassKg,
+	}
+}
+
+// toCrewMember converts a crew member to its protobuf message
+func toCrewMember(member *lib.CrewMember) *CrewMember {
+	return &CrewMember{
+		Id:        member.ID,
+		Name:      member.Name,
+		Agency:    member.Agency,
+		Image:     member.Image,
+		Wikipedia: member.Wikipedia,
+		Status:    member.Status,
+		LaunchIds: member.Launches,
+	}
+}
+
+// ListLaunches implements the LaunchService interface
+func (s *Server) ListLaunches(ctx context.Context, req *ListLaunchesRequest) (*ListLaunchesResponse, error) {
+	query, err := toLaunchQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := s.spaceClient.ListLaunches(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &ListLaunchesResponse{
+		Launches:  make([]*Launch, len(page.Launches)),
+		TotalSize: int32(page.TotalLaunches),
+	}
+	for i := range page.Launches {
+		response.Launches[i] = toLaunch(&page.Launches[i])
+	}
+	if page.HasNextPage {
+		response.NextPageToken = encodePageToken(page.Page + 1)
+	}
+	return response, nil
+}
+
+// toLaunchQuery converts a ListLaunches request to a launch query
+func toLaunchQuery(req *ListLaunchesRequest) (lib.LaunchQuery, error) {
+	query := lib.LaunchQuery{
+		Limit:     int(req.PageSize),
+		Success:   req.Success,
+		Upcoming:  req.Upcoming,
+		RocketID:  req.RocketId,
+		Ascending: req.Ascending,
+	}
+	var err error
+	if req.PageToken != "" {
+		if query.Page, err = decodePageToken(req.PageToken); err != nil {
+			return query, err
+		}
+	}
+	if req.From != "" {
+		if query.From, err = lib.ParseLaunchTime(req.From, false); err != nil {
+			return query, fmt.Errorf("from: %w", err)
+		}
+	}
+	if req.To != "" {
+		if query.To, err = lib.ParseLaunchTime(req.To, true); err != nil {
+			return query, fmt.Errorf("to: %w", err)
+		}
+	}
+	return query, query.Validate()
+}
+
+// encodePageToken returns the opaque token of a page of results
+func encodePageToken(page int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(page)))
+}
+
+// decodePageToken returns the page a token from encodePageToken stands for
+func decodePageToken(token string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+	page, err := strconv.Atoi(string(data))
+	if err != nil || page < 1 {
+		return 0, errors.New("invalid page token")
+	}
+	return page, nil
+}
+
 // GetRocket implements the LaunchService interface
 func (s *Server) GetRocket(ctx context.Context, req *GetRocketRequest) (*Rocket, error) {
-	rocket, err := s.spaceClient.GetRocket(req.Id)
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "rocket id is required")
+	}
+
+	rocket, err := s.spaceClient.GetRocket(ctx, req.Id)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
+	}
+
+	return toRocket(rocket), nil
+}
+
+// BatchGetRockets implements the LaunchService interface. The rockets are
+// fetched concurrently and every ID gets its own result, in request order.
+func (s *Server) BatchGetRockets(ctx context.Context, req *BatchGetRocketsRequest) (*BatchGetRocketsResponse, error) {
+	if err := lib.ValidateBatchIDs(req.Ids); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results := lib.GetRocketsByID(ctx, s.spaceClient, req.Ids)
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	response := &BatchGetRocketsResponse{
+		Results: make([]*RocketResult, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = &RocketResult{Id: result.ID}
+		if result.Err != nil {
+			response.Results[i].Error = toRocketError(result.Err)
+			continue
+		}
+		response.Results[i].Rocket = toRocket(result.Rocket)
 	}
 
+	return response, nil
+}
+
+// toRocket converts a rocket to its protobuf message
+func toRocket(rocket *lib.Rocket) *Rocket {
 	return &Rocket{
 		Id:           rocket.ID,
 		Name:         rocket.Name,
 		Description:  rocket.Description,
 		HeightMeters: rocket.Height.Meters,
 		MassKg:       int32(rocket.Mass.Kg),
-	}, nil
+	}
 }
 
 // GetRockets implements the LaunchService interface
 func (s *Server) GetRockets(ctx context.Context, req *GetRocketsRequest) (*GetRocketsResponse, error) {
-	rockets, err := s.spaceClient.GetAllRockets()
+	rockets, err := s.spaceClient.GetAllRockets(ctx)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	response := &GetRocketsResponse{
@@ -76,11 +410,181 @@ func (s *Server) GetRockets(ctx context.Context, req *GetRocketsRequest) (*GetRo
 	return response, nil
 }
 
+// GetCapsules implements the LaunchService interface
+func (s *Server) GetCapsules(ctx context.Context, req *GetCapsulesRequest) (*GetCapsulesResponse, error) {
+	capsules, err := s.spaceClient.GetAllCapsules(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &GetCapsulesResponse{
+		Capsules: make([]*Capsule, len(capsules)),
+	}
+	for i := range capsules {
+		response.Capsules[i] = toCapsule(&capsules[i])
+	}
+	return response, nil
+}
+
+// GetCapsule implements the LaunchService interface
+func (s *Server) GetCapsule(ctx context.Context, req *GetCapsuleRequest) (*Capsule, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "capsule id is required")
+	}
+
+	capsule, err := s.spaceClient.GetCapsule(ctx, req.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toCapsule(capsule), nil
+}
+
+// toCapsule converts a capsule to its protobuf message
+func toCapsule(capsule *lib.Capsule) *Capsule {
+	return &Capsule{
+		Id:            capsule.ID,
+		Serial:        capsule.Serial,
+		Status:        capsule.Status,
+		Type:          capsule.Type,
+		ReuseCount:    int32(capsule.ReuseCount),
+		WaterLandings: int32(capsule.WaterLandings),
+		LandLandings:  int32(capsule.LandLandings),
+		LastUpdate:    capsule.LastUpdate,
+		LaunchIds:     capsule.Launches,
+	}
+}
+
+// GetCores implements the LaunchService interface
+func (s *Server) GetCores(ctx context.Context, req *GetCoresRequest) (*GetCoresResponse, error) {
+	cores, err := s.spaceClient.GetAllCores(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &GetCoresResponse{
+		Cores: make([]*Core, len(cores)),
+	}
+	for i, core := range cores {
+		response.Cores[i] = &Core{
+			Id:           core.ID,
+			Serial:       core.Serial,
+			Block:        int32(core.Block),
+			Status:       core.Status,
+			ReuseCount:   int32(core.ReuseCount),
+			RtlsAttempts: int32(core.RTLSAttempts),
+			RtlsLandings: int32(core.RTLSLandings),
+			AsdsAttempts: int32(core.ASDSAttempts),
+			AsdsLandings: int32(core.ASDSLandings),
+			LastUpdate:   core.LastUpdate,
+			LaunchIds:    core.Launches,
+		}
+	}
+	return response, nil
+}
+
+// GetCrew implements the LaunchService interface
+func (s *Server) GetCrew(ctx context.Context, req *GetCrewRequest) (*GetCrewResponse, error) {
+	crew, err := s.spaceClient.GetAllCrew(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &GetCrewResponse{
+		Crew: make([]*CrewMember, len(crew)),
+	}
+	for i := range crew {
+		response.Crew[i] = toCrewMember(&crew[i])
+	}
+	return response, nil
+}
+
+// GetLaunchpads implements the LaunchService interface
+func (s *Server) GetLaunchpads(ctx context.Context, req *GetLaunchpadsRequest) (*GetLaunchpadsResponse, error) {
+	if req.Near == nil && req.RadiusKm != 0 {
+		return nil, status.Error(codes.InvalidArgument, "radius_km requires near")
+	}
+	var near *lib.NearQuery
+	if req.Near != nil {
+		near = &lib.NearQuery{
+			Latitude:  req.Near.Latitude,
+			Longitude: req.Near.Longitude,
+			RadiusKm:  req.RadiusKm,
+		}
+		if err := near.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	launchpads, err := s.spaceClient.GetAllLaunchpads(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &GetLaunchpadsResponse{}
+	if near == nil {
+		response.Launchpads = make([]*Launchpad, len(launchpads))
+		for i := range launchpads {
+			response.Launchpads[i] = toLaunchpad(&launchpads[i])
+		}
+		return response, nil
+	}
+	nearby := lib.LaunchpadsNear(launchpads, *near)
+	response.Launchpads = make([]*Launchpad, len(nearby))
+	for i := range nearby {
+		response.Launchpads[i] = toLaunchpad(&nearby[i].Launchpad)
+		response.Launchpads[i].DistanceKm = &nearby[i].DistanceKm
+	}
+	return response, nil
+}
+
+// GetLaunchpad implements the LaunchService interface
+func (s *Server) GetLaunchpad(ctx context.Context, req *GetLaunchpadRequest) (*Launchpad, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "launchpad id is required")
+	}
+
+	launchpad, err := s.spaceClient.GetLaunchpad(ctx, req.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toLaunchpad(launchpad), nil
+}
+
+// GetLandpads implements the LaunchService interface
+func (s *Server) GetLandpads(ctx context.Context, req *GetLandpadsRequest) (*GetLandpadsResponse, error) {
+	landpads, err := s.spaceClient.GetAllLandpads(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	response := &GetLandpadsResponse{
+		Landpads: make([]*Landpad, len(landpads)),
+	}
+	for i, landpad := range landpads {
+		response.Landpads[i] = &Landpad{
+			Id:               landpad.ID,
+			Name:             landpad.Name,
+			FullName:         landpad.FullName,
+			Type:             landpad.Type,
+			Locality:         landpad.Locality,
+			Region:           landpad.Region,
+			Latitude:         landpad.Latitude,
+			Longitude:        landpad.Longitude,
+			LandingAttempts:  int32(landpad.LandingAttempts),
+			LandingSuccesses: int32(landpad.LandingSuccesses),
+			Status:           landpad.Status,
+		}
+	}
+	return response, nil
+}
+
 // GetMathFact implements the LaunchService interface
 func (s *Server) GetMathFact(ctx context.Context, req *GetMathFactRequest) (*MathFact, error) {
-	mathFact, err := s.numbersClient.GetMathFact()
+	mathFact, err := s.numbersClient.GetMathFact(ctx)
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	return &MathFact{
@@ -91,15 +595,73 @@ func (s *Server) GetMathFact(ctx context.Context, req *GetMathFactRequest) (*Mat
 	}, nil
 }
 
+// GetAPOD implements the LaunchService interface
+func (s *Server) GetAPOD(ctx context.Context, req *GetAPODRequest) (*APOD, error) {
+	if !lib.ValidAPODDate(req.Date) {
+		return nil, status.Error(codes.InvalidArgument, "date must be formatted as YYYY-MM-DD")
+	}
+
+	apod, err := s.nasaClient.GetAPOD(ctx, req.Date)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &APOD{
+		Title:          apod.Title,
+		Date:           apod.Date,
+		Explanation:    apod.Explanation,
+		Url:            apod.URL,
+		MediaType:      apod.MediaType,
+		ServiceVersion: apod.ServiceVersion,
+	}, nil
+}
+
+// New creates a gRPC server with the LaunchService and the optional health
+// and reflection services registered, ready to Serve. Every call is tagged
+// with a request id, logged, and protected against panics.
+func New(spaceClient lib.SpaceXClientInterface, numbersClient lib.NumbersClientInterface, nasaClient lib.NASAClientInterface, opts ...ServerOption) *grpc.Server {
+	var o serverOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	srv := NewServer(spaceClient, numbersClient, nasaClient)
+	if o.watchInterval > 0 {
+		srv.watchInterval = o.watchInterval
+	}
+	if o.watchClient != nil {
+		srv.watchClient = o.watchClient
+	}
+	srv.shutdown = o.shutdown
+
+	unary, stream := interceptors(&o)
+	grpcOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if o.tls != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(o.tls)))
+	}
+
+	s := grpc.NewServer(grpcOpts...)
+	RegisterLaunchServiceServer(s, srv)
+	if o.health != nil {
+		healthpb.RegisterHealthServer(s, o.health)
+	}
+	if o.reflection {
+		reflection.Register(s)
+	}
+	return s
+}
+
 // StartServer starts the gRPC server
-func StartServer(spaceClient *lib.SpaceXClient, numbersClient *lib.NumbersClient, port string) error {
+func StartServer(spaceClient lib.SpaceXClientInterface, numbersClient lib.NumbersClientInterface, nasaClient lib.NASAClientInterface, port string, opts ...ServerOption) error {
 	lis, err := net.Listen("tcp", port)
 	if err != nil {
 		return err
 	}
 
-	s := grpc.NewServer()
-	RegisterLaunchServiceServer(s, NewServer(spaceClient, numbersClient))
+	s := New(spaceClient, numbersClient, nasaClient, opts...)
 
 	log.Printf("Starting gRPC server on %s", port)
 	return s.Serve(lis)
@@ -0,0 +1,960 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"outerspace-go/lib"
+	"outerspace-go/lib/cache"
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Mock SpaceX client
+type MockSpaceXClient struct {
+	mock.Mock
+}
+
+func (m *MockSpaceXClient) GetAllRockets(ctx context.Context) ([]lib.RocketSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.RocketSummary), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetRocket(ctx context.Context, id string) (*lib.Rocket, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Rocket), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllCapsules(ctx context.Context) ([]lib.Capsule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Capsule), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetCapsule(ctx context.Context, id string) (*lib.Capsule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Capsule), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllCores(ctx context.Context) ([]lib.Core, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Core), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllCrew(ctx context.Context) ([]lib.CrewMember, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.CrewMember), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllLaunchpads(ctx context.Context) ([]lib.Launchpad, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Launchpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLaunchpad(ctx context.Context, id string) (*lib.Launchpad, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Launchpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllLandpads(ctx context.Context) ([]lib.Landpad, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Landpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLatestLaunch(ctx context.Context) (*lib.Launch, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Launch), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetNextLaunch(ctx context.Context) (*lib.Launch, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.Launch), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetUpcomingLaunches(ctx context.Context) ([]lib.Launch, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]lib.Launch), args.Error(1)
+}
+
+func (m *MockSpaceXClient) ListLaunches(ctx context.Context, query lib.LaunchQuery) (*lib.LaunchPage, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.LaunchPage), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLaunch(ctx context.Context, id string, populate bool) (*lib.LaunchDetail, error) {
+	args := m.Called(ctx, id, populate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.LaunchDetail), args.Error(1)
+}
+
+// Mock Numbers client
+type MockNumbersClient struct {
+	mock.Mock
+}
+
+func (m *MockNumbersClient) GetMathFact(ctx context.Context) (*lib.MathFact, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.MathFact), args.Error(1)
+}
+
+// Mock NASA client
+type MockNASAClient struct {
+	mock.Mock
+}
+
+func (m *MockNASAClient) GetAPOD(ctx context.Context, date string) (*lib.APOD, error) {
+	args := m.Called(ctx, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*lib.APOD), args.Error(1)
+}
+
+// dialServer serves server over an in-memory listener and returns a client
+// connected to it
+func dialServer(t *testing.T, server *grpc.Server) LaunchServiceClient {
+	return NewLaunchServiceClient(dialConn(t, server))
+}
+
+// dialConn serves server over an in-memory listener and returns a connection to it
+func dialConn(t *testing.T, server *grpc.Server) *grpc.ClientConn {
+	lis := bufconn.Listen(1 << 20)
+	go server.Serve(lis)
+	// The connection is closed first, so GracefulStop only waits for the
+	// handlers of cancelled streams to return and log
+	t.Cleanup(server.GracefulStop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+// testServer is a LaunchService backed by mock clients
+type testServer struct {
+	client  LaunchServiceClient
+	spaceX  *MockSpaceXClient
+	numbers *MockNumbersClient
+	nasa    *MockNASAClient
+}
+
+func newTestServer(t *testing.T, opts ...ServerOption) *testServer {
+	ts := &testServer{
+		spaceX:  new(MockSpaceXClient),
+		numbers: new(MockNumbersClient),
+		nasa:    new(MockNASAClient),
+	}
+	ts.client = dialServer(t, New(ts.spaceX, ts.numbers, ts.nasa, opts...))
+	t.Cleanup(func() {
+		ts.spaceX.AssertExpectations(t)
+		ts.numbers.AssertExpectations(t)
+		ts.nasa.AssertExpectations(t)
+	})
+	return ts
+}
+
+func TestServer_GetLatestLaunch(t *testing.T) {
+	ts := newTestServer(t)
+	success := true
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{
+		FlightNumber: 100,
+		MissionName:  "Mission X",
+		DateUTC:      "2023-01-01T12:00:00Z",
+		Success:      &success,
+		Details:      "Test mission",
+	}, nil)
+
+	launch, err := ts.client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(100), launch.FlightNumber)
+	assert.Equal(t, "Mission X", launch.MissionName)
+	assert.Equal(t, "2023-01-01T12:00:00Z", launch.DateUtc)
+	require.NotNil(t, launch.Success)
+	assert.True(t, *launch.Success)
+	assert.Equal(t, "Test mission", launch.Details)
+}
+
+func TestServer_GetLatestLaunch_Error(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrUnavailable})
+
+	_, err := ts.client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServer_GetNextLaunch(t *testing.T) {
+	ts := newTestServer(t)
+	date := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	ts.spaceX.On("GetNextLaunch", mock.Anything).Return(&lib.Launch{
+		ID:            "abc",
+		FlightNumber:  187,
+		MissionName:   "USSF-44",
+		DateUTC:       date,
+		DatePrecision: "hour",
+		Upcoming:      true,
+	}, nil)
+
+	resp, err := ts.client.GetNextLaunch(context.Background(), &GetNextLaunchRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "USSF-44", resp.Launch.MissionName)
+	assert.Equal(t, "hour", resp.Launch.DatePrecision)
+	assert.True(t, resp.Launch.Upcoming)
+	assert.InDelta(t, 3600, resp.SecondsUntilLaunch, 5)
+}
+
+func TestServer_GetNextLaunch_InvalidDate(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetNextLaunch", mock.Anything).Return(&lib.Launch{ID: "abc", DateUTC: "TBD"}, nil)
+
+	_, err := ts.client.GetNextLaunch(context.Background(), &GetNextLaunchRequest{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServer_GetLaunch(t *testing.T) {
+	ts := newTestServer(t)
+	success, landed := true, false
+	launch := &lib.LaunchDetail{
+		ID:            "abc",
+		FlightNumber:  94,
+		MissionName:   "Crew-1",
+		DatePrecision: "hour",
+		Success:       &success,
+		Failures:      []lib.LaunchFailure{{Time: 139, Altitude: 40, Reason: "engine shutdown"}},
+		Cores:         []lib.LaunchCore{{CoreID: "b1061", LandingAttempt: true, LandingSuccess: &landed}},
+		Rocket:        lib.Ref[lib.Rocket]{ID: "falcon9", Value: &lib.Rocket{ID: "falcon9", Name: "Falcon 9"}},
+		Launchpad:     lib.Ref[lib.Launchpad]{ID: "39a", Value: &lib.Launchpad{ID: "39a", Latitude: 28.6}},
+		Payloads:      []lib.Ref[lib.Payload]{{ID: "p1", Value: &lib.Payload{ID: "p1", Orbit: "ISS"}}},
+		Crew:          []lib.Ref[lib.CrewMember]{{ID: "c1", Value: &lib.CrewMember{ID: "c1", Name: "Michael Hopkins"}}},
+	}
+	launch.Links.YouTubeID = "bnChQbxLkkI"
+	launch.Links.Patch.Small = "https://example.com/small.png"
+	ts.spaceX.On("GetLaunch", mock.Anything, "abc", true).Return(launch, nil)
+
+	resp, err := ts.client.GetLaunch(context.Background(), &GetLaunchRequest{Id: "abc", Populate: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Crew-1", resp.MissionName)
+	assert.Equal(t, "hour", resp.DatePrecision)
+	assert.True(t, resp.GetSuccess())
+	assert.Equal(t, "bnChQbxLkkI", resp.Links.YoutubeId)
+	assert.Equal(t, "https://example.com/small.png", resp.Links.PatchSmall)
+	require.Len(t, resp.Failures, 1)
+	assert.Equal(t, int32(139), resp.Failures[0].Time)
+	require.Len(t, resp.Cores, 1)
+	assert.Equal(t, "b1061", resp.Cores[0].CoreId)
+	require.NotNil(t, resp.Cores[0].LandingSuccess)
+	assert.False(t, resp.Cores[0].GetLandingSuccess())
+	assert.Equal(t, "falcon9", resp.RocketId)
+	assert.Equal(t, "Falcon 9", resp.Rocket.Name)
+	assert.Equal(t, 28.6, resp.Launchpad.Latitude)
+	assert.Equal(t, []string{"p1"}, resp.PayloadIds)
+	assert.Equal(t, "ISS", resp.Payloads[0].Orbit)
+	assert.Equal(t, []string{"c1"}, resp.CrewIds)
+	assert.Equal(t, "Michael Hopkins", resp.Crew[0].Name)
+}
+
+func TestServer_GetLaunch_Unpopulated(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetLaunch", mock.Anything, "abc", false).Return(&lib.LaunchDetail{
+		ID:       "abc",
+		Rocket:   lib.Ref[lib.Rocket]{ID: "falcon9"},
+		Payloads: []lib.Ref[lib.Payload]{{ID: "p1"}, {ID: "p2"}},
+	}, nil)
+
+	resp, err := ts.client.GetLaunch(context.Background(), &GetLaunchRequest{Id: "abc"})
+
+	require.NoError(t, err)
+	assert.Nil(t, resp.Success)
+	assert.Equal(t, "falcon9", resp.RocketId)
+	assert.Nil(t, resp.Rocket)
+	assert.Nil(t, resp.Launchpad)
+	assert.Equal(t, []string{"p1", "p2"}, resp.PayloadIds)
+	assert.Empty(t, resp.Payloads)
+}
+
+func TestServer_GetLaunch_EmptyID(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.client.GetLaunch(context.Background(), &GetLaunchRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	ts.spaceX.AssertNotCalled(t, "GetLaunch")
+}
+
+func TestServer_ListLaunches(t *testing.T) {
+	ts := newTestServer(t)
+	success := true
+	ts.spaceX.On("ListLaunches", mock.Anything, mock.MatchedBy(func(query lib.LaunchQuery) bool {
+		return query.Page == 0 && query.Limit == 2 && *query.Success && query.Upcoming == nil &&
+			query.From.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)) && query.RocketID == "falcon9"
+	})).Return(&lib.LaunchPage{
+		Launches:      []lib.Launch{{ID: "a", FlightNumber: 2, RocketID: "falcon9"}, {ID: "b", FlightNumber: 1, RocketID: "falcon9"}},
+		Page:          1,
+		Limit:         2,
+		TotalLaunches: 3,
+		TotalPages:    2,
+		HasNextPage:   true,
+	}, nil).Once()
+	ts.spaceX.On("ListLaunches", mock.Anything, mock.MatchedBy(func(query lib.LaunchQuery) bool {
+		return query.Page == 2
+	})).Return(&lib.LaunchPage{
+		Launches:      []lib.Launch{{ID: "c", FlightNumber: 0}},
+		Page:          2,
+		Limit:         2,
+		TotalLaunches: 3,
+		TotalPages:    2,
+	}, nil).Once()
+
+	req := &ListLaunchesRequest{PageSize: 2, From: "2020-01-01", Success: &success, RocketId: "falcon9"}
+	resp, err := ts.client.ListLaunches(context.Background(), req)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Launches, 2)
+	assert.Equal(t, "a", resp.Launches[0].Id)
+	assert.Equal(t, "falcon9", resp.Launches[0].RocketId)
+	assert.Equal(t, int32(3), resp.TotalSize)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	req.PageToken = resp.NextPageToken
+	resp, err = ts.client.ListLaunches(context.Background(), req)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Launches, 1)
+	assert.Equal(t, "c", resp.Launches[0].Id)
+	assert.Empty(t, resp.NextPageToken)
+}
+
+func TestServer_ListLaunches_InvalidArgument(t *testing.T) {
+	ts := newTestServer(t)
+
+	for _, req := range []*ListLaunchesRequest{
+		{PageToken: "not a token"},
+		{PageToken: encodePageToken(0)},
+		{PageSize: -1},
+		{PageSize: lib.MaxLaunchPageSize + 1},
+		{From: "yesterday"},
+		{From: "2021-01-01", To: "2020-01-01"},
+	} {
+		_, err := ts.client.ListLaunches(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+	ts.spaceX.AssertNotCalled(t, "ListLaunches")
+}
+
+func TestServer_ListLaunches_Error(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("ListLaunches", mock.Anything, mock.Anything).Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrRateLimited, StatusCode: 429})
+
+	_, err := ts.client.ListLaunches(context.Background(), &ListLaunchesRequest{})
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestPageToken(t *testing.T) {
+	page, err := decodePageToken(encodePageToken(42))
+	require.NoError(t, err)
+	assert.Equal(t, 42, page)
+
+	_, err = decodePageToken("@@@")
+	assert.Error(t, err)
+}
+
+func TestServer_GetRocket(t *testing.T) {
+	ts := newTestServer(t)
+	rocket := &lib.Rocket{ID: "123", Name: "Falcon 9", Description: "Orbital rocket"}
+	rocket.Height.Meters = 70
+	rocket.Mass.Kg = 549054
+	ts.spaceX.On("GetRocket", mock.Anything, "123").Return(rocket, nil)
+
+	resp, err := ts.client.GetRocket(context.Background(), &GetRocketRequest{Id: "123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "123", resp.Id)
+	assert.Equal(t, "Falcon 9", resp.Name)
+	assert.Equal(t, "Orbital rocket", resp.Description)
+	assert.Equal(t, float64(70), resp.HeightMeters)
+	assert.Equal(t, int32(549054), resp.MassKg)
+}
+
+func TestServer_GetRocket_EmptyID(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.client.GetRocket(context.Background(), &GetRocketRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	ts.spaceX.AssertNotCalled(t, "GetRocket")
+}
+
+func TestServer_GetRocket_NotFound(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetRocket", mock.Anything, "999").Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrNotFound, StatusCode: 404})
+
+	_, err := ts.client.GetRocket(context.Background(), &GetRocketRequest{Id: "999"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_BatchGetRockets(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetRocket", mock.Anything, "falcon9").Return(&lib.Rocket{ID: "falcon9", Name: "Falcon 9"}, nil)
+	ts.spaceX.On("GetRocket", mock.Anything, "unknown").Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrNotFound, StatusCode: 404})
+	ts.spaceX.On("GetRocket", mock.Anything, "starship").Return(&lib.Rocket{ID: "starship", Name: "Starship"}, nil)
+
+	resp, err := ts.client.BatchGetRockets(context.Background(), &BatchGetRocketsRequest{Ids: []string{"starship", "unknown", "falcon9"}})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	assert.Equal(t, "starship", resp.Results[0].Id)
+	assert.Equal(t, "Starship", resp.Results[0].Rocket.Name)
+	assert.Nil(t, resp.Results[0].Error)
+	assert.Equal(t, "unknown", resp.Results[1].Id)
+	assert.Nil(t, resp.Results[1].Rocket)
+	require.NotNil(t, resp.Results[1].Error)
+	assert.Equal(t, int32(codes.NotFound), resp.Results[1].Error.Code)
+	assert.Equal(t, ReasonUpstreamNotFound, resp.Results[1].Error.Reason)
+	assert.Equal(t, lib.SpaceXUpstream, resp.Results[1].Error.Upstream)
+	assert.False(t, resp.Results[1].Error.Retryable)
+	assert.Equal(t, "falcon9", resp.Results[2].Id)
+	assert.Equal(t, "Falcon 9", resp.Results[2].Rocket.Name)
+}
+
+func TestServer_BatchGetRockets_InvalidIDs(t *testing.T) {
+	ts := newTestServer(t)
+
+	for _, ids := range [][]string{nil, {"falcon9", ""}, make([]string, lib.MaxBatchIDs+1)} {
+		_, err := ts.client.BatchGetRockets(context.Background(), &BatchGetRocketsRequest{Ids: ids})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	ts.spaceX.AssertNotCalled(t, "GetRocket")
+}
+
+func TestServer_GetRockets(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllRockets", mock.Anything).Return([]lib.RocketSummary{
+		{ID: "123", Name: "Falcon 9"},
+		{ID: "456", Name: "Falcon Heavy"},
+	}, nil)
+
+	resp, err := ts.client.GetRockets(context.Background(), &GetRocketsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Rockets, 2)
+	assert.Equal(t, "123", resp.Rockets[0].Id)
+	assert.Equal(t, "Falcon 9", resp.Rockets[0].Name)
+	assert.Equal(t, "456", resp.Rockets[1].Id)
+	assert.Equal(t, "Falcon Heavy", resp.Rockets[1].Name)
+}
+
+func TestServer_GetRockets_Error(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllRockets", mock.Anything).Return(nil, errors.New("boom"))
+
+	_, err := ts.client.GetRockets(context.Background(), &GetRocketsRequest{})
+
+	// Errors that are not upstream errors do not leak their message
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestServer_GetCapsules(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllCapsules", mock.Anything).Return([]lib.Capsule{
+		{ID: "1", Serial: "C101", Status: "retired", WaterLandings: 1, Launches: []string{"a"}},
+		{ID: "2", Serial: "C206", Status: "active", ReuseCount: 2},
+	}, nil)
+
+	resp, err := ts.client.GetCapsules(context.Background(), &GetCapsulesRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Capsules, 2)
+	assert.Equal(t, "C101", resp.Capsules[0].Serial)
+	assert.Equal(t, int32(1), resp.Capsules[0].WaterLandings)
+	assert.Equal(t, []string{"a"}, resp.Capsules[0].LaunchIds)
+	assert.Equal(t, int32(2), resp.Capsules[1].ReuseCount)
+}
+
+func TestServer_GetCapsule(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetCapsule", mock.Anything, "2").Return(&lib.Capsule{ID: "2", Serial: "C206", Type: "Dragon 2.0"}, nil)
+
+	resp, err := ts.client.GetCapsule(context.Background(), &GetCapsuleRequest{Id: "2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "C206", resp.Serial)
+	assert.Equal(t, "Dragon 2.0", resp.Type)
+}
+
+func TestServer_GetCapsule_EmptyID(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.client.GetCapsule(context.Background(), &GetCapsuleRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	ts.spaceX.AssertNotCalled(t, "GetCapsule")
+}
+
+func TestServer_GetCapsule_NotFound(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetCapsule", mock.Anything, "999").Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrNotFound, StatusCode: 404})
+
+	_, err := ts.client.GetCapsule(context.Background(), &GetCapsuleRequest{Id: "999"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServer_GetCores(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllCores", mock.Anything).Return([]lib.Core{
+		{ID: "1", Serial: "B1058", Block: 5, Status: "lost", ReuseCount: 13, ASDSAttempts: 14, ASDSLandings: 14},
+	}, nil)
+
+	resp, err := ts.client.GetCores(context.Background(), &GetCoresRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Cores, 1)
+	assert.Equal(t, "B1058", resp.Cores[0].Serial)
+	assert.Equal(t, int32(5), resp.Cores[0].Block)
+	assert.Equal(t, int32(13), resp.Cores[0].ReuseCount)
+	assert.Equal(t, int32(14), resp.Cores[0].AsdsLandings)
+}
+
+func TestServer_GetCrew(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllCrew", mock.Anything).Return([]lib.CrewMember{
+		{ID: "1", Name: "Robert Behnken", Agency: "NASA", Status: "active", Launches: []string{"a"}},
+	}, nil)
+
+	resp, err := ts.client.GetCrew(context.Background(), &GetCrewRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Crew, 1)
+	assert.Equal(t, "Robert Behnken", resp.Crew[0].Name)
+	assert.Equal(t, "active", resp.Crew[0].Status)
+	assert.Equal(t, []string{"a"}, resp.Crew[0].LaunchIds)
+}
+
+func TestServer_GetCrew_Error(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllCrew", mock.Anything).Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrUnavailable})
+
+	_, err := ts.client.GetCrew(context.Background(), &GetCrewRequest{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServer_GetLaunchpads(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllLaunchpads", mock.Anything).Return([]lib.Launchpad{
+		{ID: "vafb", Name: "VAFB SLC 4E", Region: "California", Latitude: 34.632093, Longitude: -120.610829},
+		{ID: "ksc", Name: "KSC LC 39A", Latitude: 28.6080585, Longitude: -80.6039558, LaunchAttempts: 55, LaunchSuccesses: 54},
+	}, nil)
+
+	resp, err := ts.client.GetLaunchpads(context.Background(), &GetLaunchpadsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Launchpads, 2)
+	assert.Equal(t, "vafb", resp.Launchpads[0].Id)
+	assert.Equal(t, "California", resp.Launchpads[0].Region)
+	assert.Nil(t, resp.Launchpads[0].DistanceKm)
+	assert.Equal(t, int32(55), resp.Launchpads[1].LaunchAttempts)
+	assert.Equal(t, int32(54), resp.Launchpads[1].LaunchSuccesses)
+}
+
+func TestServer_GetLaunchpads_Near(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllLaunchpads", mock.Anything).Return([]lib.Launchpad{
+		{ID: "vafb", Latitude: 34.632093, Longitude: -120.610829},
+		{ID: "boca", Latitude: 25.9972641, Longitude: -97.1560845},
+		{ID: "ksc", Latitude: 28.6080585, Longitude: -80.6039558},
+	}, nil)
+
+	resp, err := ts.client.GetLaunchpads(context.Background(), &GetLaunchpadsRequest{
+		Near:     &LatLng{Latitude: 34.05, Longitude: -118.24},
+		RadiusKm: 2500,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Launchpads, 2)
+	assert.Equal(t, "vafb", resp.Launchpads[0].Id)
+	assert.Equal(t, "boca", resp.Launchpads[1].Id)
+	require.NotNil(t, resp.Launchpads[0].DistanceKm)
+	assert.Less(t, resp.Launchpads[0].GetDistanceKm(), resp.Launchpads[1].GetDistanceKm())
+}
+
+func TestServer_GetLaunchpads_InvalidArgument(t *testing.T) {
+	ts := newTestServer(t)
+
+	for _, req := range []*GetLaunchpadsRequest{
+		{RadiusKm: 100},
+		{Near: &LatLng{Latitude: -91}},
+		{Near: &LatLng{Longitude: 200}},
+		{Near: &LatLng{}, RadiusKm: -1},
+	} {
+		_, err := ts.client.GetLaunchpads(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+	}
+	ts.spaceX.AssertNotCalled(t, "GetAllLaunchpads")
+}
+
+func TestServer_GetLaunchpad(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetLaunchpad", mock.Anything, "ksc").Return(&lib.Launchpad{ID: "ksc", Name: "KSC LC 39A", Status: "active"}, nil)
+
+	resp, err := ts.client.GetLaunchpad(context.Background(), &GetLaunchpadRequest{Id: "ksc"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "KSC LC 39A", resp.Name)
+	assert.Equal(t, "active", resp.Status)
+}
+
+func TestServer_GetLaunchpad_EmptyID(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.client.GetLaunchpad(context.Background(), &GetLaunchpadRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	ts.spaceX.AssertNotCalled(t, "GetLaunchpad")
+}
+
+func TestServer_GetLandpads(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetAllLandpads", mock.Anything).Return([]lib.Landpad{
+		{ID: "ocisly", Name: "OCISLY", Type: "ASDS", Latitude: 28.4104, Longitude: -80.6188, LandingAttempts: 36, LandingSuccesses: 35},
+	}, nil)
+
+	resp, err := ts.client.GetLandpads(context.Background(), &GetLandpadsRequest{})
+
+	require.NoError(t, err)
+	require.Len(t, resp.Landpads, 1)
+	assert.Equal(t, "ASDS", resp.Landpads[0].Type)
+	assert.Equal(t, 28.4104, resp.Landpads[0].Latitude)
+	assert.Equal(t, int32(36), resp.Landpads[0].LandingAttempts)
+	assert.Equal(t, int32(35), resp.Landpads[0].LandingSuccesses)
+}
+
+func TestServer_GetMathFact(t *testing.T) {
+	ts := newTestServer(t)
+	ts.numbers.On("GetMathFact", mock.Anything).Return(&lib.MathFact{Text: "42 is the answer", Number: 42, Found: true, Type: "math"}, nil)
+
+	fact, err := ts.client.GetMathFact(context.Background(), &GetMathFactRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "42 is the answer", fact.Text)
+	assert.Equal(t, int32(42), fact.Number)
+	assert.True(t, fact.Found)
+	assert.Equal(t, "math", fact.Type)
+}
+
+func TestServer_GetMathFact_RateLimited(t *testing.T) {
+	ts := newTestServer(t)
+	ts.numbers.On("GetMathFact", mock.Anything).Return(nil, &upstream.Error{Upstream: lib.NumbersUpstream, Kind: upstream.ErrRateLimited, StatusCode: 429})
+
+	_, err := ts.client.GetMathFact(context.Background(), &GetMathFactRequest{})
+
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestServer_GetAPOD(t *testing.T) {
+	ts := newTestServer(t)
+	ts.nasa.On("GetAPOD", mock.Anything, "2024-04-08").Return(&lib.APOD{
+		Title:          "Eclipse",
+		Date:           "2024-04-08",
+		Explanation:    "The Moon covers the Sun",
+		URL:            "https://apod.nasa.gov/eclipse.jpg",
+		MediaType:      "image",
+		ServiceVersion: "v1",
+	}, nil)
+
+	apod, err := ts.client.GetAPOD(context.Background(), &GetAPODRequest{Date: "2024-04-08"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Eclipse", apod.Title)
+	assert.Equal(t, "2024-04-08", apod.Date)
+	assert.Equal(t, "The Moon covers the Sun", apod.Explanation)
+	assert.Equal(t, "https://apod.nasa.gov/eclipse.jpg", apod.Url)
+	assert.Equal(t, "image", apod.MediaType)
+	assert.Equal(t, "v1", apod.ServiceVersion)
+}
+
+func TestServer_GetAPOD_InvalidDate(t *testing.T) {
+	ts := newTestServer(t)
+
+	_, err := ts.client.GetAPOD(context.Background(), &GetAPODRequest{Date: "yesterday"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	ts.nasa.AssertNotCalled(t, "GetAPOD")
+}
+
+func TestServer_GetAPOD_Timeout(t *testing.T) {
+	ts := newTestServer(t)
+	ts.nasa.On("GetAPOD", mock.Anything, "").Return(nil, &upstream.Error{Upstream: lib.NASAUpstream, Kind: upstream.ErrTimeout})
+
+	_, err := ts.client.GetAPOD(context.Background(), &GetAPODRequest{})
+
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestServer_PassesCallerContext(t *testing.T) {
+	ts := newTestServer(t)
+	ts.spaceX.On("GetLatestLaunch", mock.MatchedBy(func(ctx context.Context) bool {
+		_, ok := ctx.Deadline()
+		return ok
+	})).Return(&lib.Launch{FlightNumber: 1}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err := ts.client.GetLatestLaunch(ctx, &LatestLaunchRequest{})
+
+	assert.NoError(t, err)
+}
+
+// newCachedTestServer serves the API from the response cache in front of
+// the mocks, as main does
+func newCachedTestServer(t *testing.T, ttl cache.TTL, opts ...ServerOption) *testServer {
+	ts := &testServer{
+		spaceX:  new(MockSpaceXClient),
+		numbers: new(MockNumbersClient),
+		nasa:    new(MockNASAClient),
+	}
+	responseCache := cache.New(100)
+	cachedSpaceX := cache.NewSpaceXClient(ts.spaceX, responseCache, cache.SpaceXTTLs{
+		Rockets:      ttl,
+		Fleet:        ttl,
+		LatestLaunch: ttl,
+		Launches:     ttl,
+	})
+	cachedNumbers := cache.NewNumbersClient(ts.numbers, responseCache, ttl)
+	cachedNASA := cache.NewNASAClient(ts.nasa, responseCache, ttl)
+	ts.client = dialServer(t, New(cachedSpaceX, cachedNumbers, cachedNASA, opts...))
+	t.Cleanup(func() {
+		ts.spaceX.AssertExpectations(t)
+		ts.numbers.AssertExpectations(t)
+		ts.nasa.AssertExpectations(t)
+	})
+	return ts
+}
+
+func TestServer_CacheHit(t *testing.T) {
+	ts := newCachedTestServer(t, cache.TTL{Fresh: time.Hour})
+	ts.spaceX.On("GetRocket", mock.Anything, "falcon9").Return(&lib.Rocket{ID: "falcon9", Name: "Falcon 9"}, nil).Once()
+
+	for i := 0; i < 3; i++ {
+		rocket, err := ts.client.GetRocket(context.Background(), &GetRocketRequest{Id: "falcon9"})
+		require.NoError(t, err)
+		assert.Equal(t, "Falcon 9", rocket.Name)
+	}
+}
+
+func TestServer_CacheDoesNotKeepErrors(t *testing.T) {
+	ts := newCachedTestServer(t, cache.TTL{Fresh: time.Hour})
+	ts.spaceX.On("GetRocket", mock.Anything, "falcon9").Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrUnavailable}).Once()
+	ts.spaceX.On("GetRocket", mock.Anything, "falcon9").Return(&lib.Rocket{ID: "falcon9", Name: "Falcon 9"}, nil).Once()
+
+	_, err := ts.client.GetRocket(context.Background(), &GetRocketRequest{Id: "falcon9"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	rocket, err := ts.client.GetRocket(context.Background(), &GetRocketRequest{Id: "falcon9"})
+	require.NoError(t, err)
+	assert.Equal(t, "Falcon 9", rocket.Name)
+}
+
+func TestServer_CacheServesStaleDuringOutage(t *testing.T) {
+	ts := newCachedTestServer(t, cache.TTL{Fresh: 20 * time.Millisecond, Stale: time.Hour})
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 1, MissionName: "First"}, nil).Once()
+	refreshed := make(chan struct{})
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrUnavailable}).Once().
+		Run(func(mock.Arguments) { close(refreshed) })
+
+	launch, err := ts.client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "First", launch.MissionName)
+
+	// Once stale, the launch is still answered while SpaceX fails to refresh it
+	time.Sleep(50 * time.Millisecond)
+	launch, err = ts.client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "First", launch.MissionName)
+
+	select {
+	case <-refreshed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stale launch was not refreshed in the background")
+	}
+}
+
+func TestServer_WatchLatestLaunch(t *testing.T) {
+	ts := newTestServer(t, WithWatchInterval(time.Millisecond))
+	success := true
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 1, MissionName: "First"}, nil).Once()
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 1, MissionName: "First"}, nil).Once()
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(nil, &upstream.Error{Upstream: lib.SpaceXUpstream, Kind: upstream.ErrUnavailable}).Once()
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 1, MissionName: "First", Success: &success}, nil).Once()
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 2, MissionName: "Second", Success: &success}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := &Client{client: ts.client}
+	var launches []*Launch
+	err := client.WatchLatestLaunch(ctx, func(launch *Launch) {
+		launches = append(launches, launch)
+		if len(launches) == 3 {
+			cancel()
+		}
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, launches, 3)
+	assert.Equal(t, int32(1), launches[0].FlightNumber)
+	// The outcome of the launch becoming known counts as a change
+	assert.Nil(t, launches[0].Success)
+	assert.Equal(t, int32(1), launches[1].FlightNumber)
+	require.NotNil(t, launches[1].Success)
+	assert.True(t, *launches[1].Success)
+	assert.Equal(t, int32(2), launches[2].FlightNumber)
+	assert.Equal(t, "Second", launches[2].MissionName)
+}
+
+func TestServer_WatchLatestLaunch_BypassesCache(t *testing.T) {
+	const interval = 100 * time.Millisecond
+	spaceX := new(MockSpaceXClient)
+	ts := newCachedTestServer(t, cache.TTL{Fresh: time.Hour}, WithWatchInterval(interval), WithWatchClient(spaceX))
+	first := &lib.Launch{FlightNumber: 1, MissionName: "First"}
+	// The cached client is only asked once; the watcher polls the raw client
+	ts.spaceX.On("GetLatestLaunch", mock.Anything).Return(first, nil).Once()
+	spaceX.On("GetLatestLaunch", mock.Anything).Return(first, nil).Once()
+	spaceX.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 2, MissionName: "Second"}, nil)
+
+	// Fill the cache with the first launch for the next hour
+	launch, err := ts.client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "First", launch.MissionName)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := &Client{client: ts.client}
+	var launches []*Launch
+	var received []time.Time
+	err = client.WatchLatestLaunch(ctx, func(launch *Launch) {
+		launches = append(launches, launch)
+		received = append(received, time.Now())
+		if len(launches) == 2 {
+			cancel()
+		}
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, launches, 2)
+	assert.Equal(t, "First", launches[0].MissionName)
+	assert.Equal(t, "Second", launches[1].MissionName)
+	// The change is picked up on the next tick, not when the cache expires
+	assert.Less(t, received[1].Sub(received[0]), 2*interval)
+
+	// Unary calls keep answering from the cache
+	launch, err = ts.client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "First", launch.MissionName)
+	spaceX.AssertExpectations(t)
+}
+
+// launchStream is a WatchLatestLaunch stream that records what is sent
+type launchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *Launch
+}
+
+func (s *launchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *launchStream) Send(launch *Launch) error {
+	s.sent <- launch
+	return nil
+}
+
+func TestServer_WatchLatestLaunch_Cancel(t *testing.T) {
+	spaceX := new(MockSpaceXClient)
+	spaceX.On("GetLatestLaunch", mock.Anything).Return(&lib.Launch{FlightNumber: 1}, nil)
+	srv := NewServer(spaceX, new(MockNumbersClient), new(MockNASAClient))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &launchStream{ctx: ctx, sent: make(chan *Launch, 1)}
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.WatchLatestLaunch(&WatchLatestLaunchRequest{}, stream)
+	}()
+
+	launch := <-stream.sent
+	assert.Equal(t, int32(1), launch.FlightNumber)
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.Equal(t, codes.Canceled, status.Code(err))
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchLatestLaunch did not return after the caller went away")
+	}
+}
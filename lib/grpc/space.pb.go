@@ -58,6 +58,362 @@ func (*LatestLaunchRequest) Descriptor() ([]byte, []int) {
 	return file_lib_grpc_space_proto_rawDescGZIP(), []int{0}
 }
 
+// Request message for watching the latest launch
+type WatchLatestLaunchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchLatestLaunchRequest) Reset() {
+	*x = WatchLatestLaunchRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchLatestLaunchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchLatestLaunchRequest) ProtoMessage() {}
+
+func (x *WatchLatestLaunchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchLatestLaunchRequest.ProtoReflect.Descriptor instead.
+func (*WatchLatestLaunchRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{1}
+}
+
+// Request message for getting the next launch
+type GetNextLaunchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNextLaunchRequest) Reset() {
+	*x = GetNextLaunchRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNextLaunchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextLaunchRequest) ProtoMessage() {}
+
+func (x *GetNextLaunchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextLaunchRequest.ProtoReflect.Descriptor instead.
+func (*GetNextLaunchRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{2}
+}
+
+// Response message containing a launch and the time left until it
+type LaunchCountdown struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Launch *Launch                `protobuf:"bytes,1,opt,name=launch,proto3" json:"launch,omitempty"`
+	// Negative once the launch date has passed; only as precise as the
+	// launch's date_precision
+	SecondsUntilLaunch int64 `protobuf:"varint,2,opt,name=seconds_until_launch,json=secondsUntilLaunch,proto3" json:"seconds_until_launch,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LaunchCountdown) Reset() {
+	*x = LaunchCountdown{}
+	mi := &file_lib_grpc_space_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaunchCountdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchCountdown) ProtoMessage() {}
+
+func (x *LaunchCountdown) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaunchCountdown.ProtoReflect.Descriptor instead.
+func (*LaunchCountdown) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LaunchCountdown) GetLaunch() *Launch {
+	if x != nil {
+		return x.Launch
+	}
+	return nil
+}
+
+func (x *LaunchCountdown) GetSecondsUntilLaunch() int64 {
+	if x != nil {
+		return x.SecondsUntilLaunch
+	}
+	return 0
+}
+
+// Request message for getting a launch
+type GetLaunchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Also return the rocket, launchpad, payloads and crew, not only their IDs
+	Populate      bool `protobuf:"varint,2,opt,name=populate,proto3" json:"populate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLaunchRequest) Reset() {
+	*x = GetLaunchRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLaunchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLaunchRequest) ProtoMessage() {}
+
+func (x *GetLaunchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLaunchRequest.ProtoReflect.Descriptor instead.
+func (*GetLaunchRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetLaunchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLaunchRequest) GetPopulate() bool {
+	if x != nil {
+		return x.Populate
+	}
+	return false
+}
+
+// Request message for listing launches
+type ListLaunchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Launches per page, at most 100; 10 when 0
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page; the first page when empty. The
+	// other fields must be the same as for the previous page
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only launches on or after from and on or before to, as RFC 3339 or
+	// YYYY-MM-DD; open ended when empty
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Only launches that did or did not succeed, when set
+	Success *bool `protobuf:"varint,5,opt,name=success,proto3,oneof" json:"success,omitempty"`
+	// Only upcoming or past launches, when set
+	Upcoming *bool `protobuf:"varint,6,opt,name=upcoming,proto3,oneof" json:"upcoming,omitempty"`
+	// Only launches of this rocket, when set
+	RocketId string `protobuf:"bytes,7,opt,name=rocket_id,json=rocketId,proto3" json:"rocket_id,omitempty"`
+	// Oldest launches first instead of newest first
+	Ascending     bool `protobuf:"varint,8,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLaunchesRequest) Reset() {
+	*x = ListLaunchesRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLaunchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLaunchesRequest) ProtoMessage() {}
+
+func (x *ListLaunchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLaunchesRequest.ProtoReflect.Descriptor instead.
+func (*ListLaunchesRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListLaunchesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLaunchesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListLaunchesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListLaunchesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListLaunchesRequest) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *ListLaunchesRequest) GetUpcoming() bool {
+	if x != nil && x.Upcoming != nil {
+		return *x.Upcoming
+	}
+	return false
+}
+
+func (x *ListLaunchesRequest) GetRocketId() string {
+	if x != nil {
+		return x.RocketId
+	}
+	return ""
+}
+
+func (x *ListLaunchesRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+// Response message for listing launches
+type ListLaunchesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Launches []*Launch              `protobuf:"bytes,1,rep,name=launches,proto3" json:"launches,omitempty"`
+	// Token of the next page; empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of launches matching the filters, on all pages
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLaunchesResponse) Reset() {
+	*x = ListLaunchesResponse{}
+	mi := &file_lib_grpc_space_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLaunchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLaunchesResponse) ProtoMessage() {}
+
+func (x *ListLaunchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLaunchesResponse.ProtoReflect.Descriptor instead.
+func (*ListLaunchesResponse) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListLaunchesResponse) GetLaunches() []*Launch {
+	if x != nil {
+		return x.Launches
+	}
+	return nil
+}
+
+func (x *ListLaunchesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListLaunchesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
 // Request message for getting a specific rocket
 type GetRocketRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -68,7 +424,7 @@ type GetRocketRequest struct {
 
 func (x *GetRocketRequest) Reset() {
 	*x = GetRocketRequest{}
-	mi := &file_lib_grpc_space_proto_msgTypes[1]
+	mi := &file_lib_grpc_space_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -79,8 +435,1943 @@ func (x *GetRocketRequest) String() string {
 
 func (*GetRocketRequest) ProtoMessage() {}
 
-func (x *GetRocketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lib_grpc_space_proto_msgTypes[1]
+func (x *GetRocketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRocketRequest.ProtoReflect.Descriptor instead.
+func (*GetRocketRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRocketRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request message for getting all rockets
+type GetRocketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRocketsRequest) Reset() {
+	*x = GetRocketsRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRocketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRocketsRequest) ProtoMessage() {}
+
+func (x *GetRocketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRocketsRequest.ProtoReflect.Descriptor instead.
+func (*GetRocketsRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{8}
+}
+
+// Response message for getting all rockets
+type GetRocketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rockets       []*RocketSummary       `protobuf:"bytes,1,rep,name=rockets,proto3" json:"rockets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRocketsResponse) Reset() {
+	*x = GetRocketsResponse{}
+	mi := &file_lib_grpc_space_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRocketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRocketsResponse) ProtoMessage() {}
+
+func (x *GetRocketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRocketsResponse.ProtoReflect.Descriptor instead.
+func (*GetRocketsResponse) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRocketsResponse) GetRockets() []*RocketSummary {
+	if x != nil {
+		return x.Rockets
+	}
+	return nil
+}
+
+// Request message for getting several rockets by ID
+type BatchGetRocketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetRocketsRequest) Reset() {
+	*x = BatchGetRocketsRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetRocketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRocketsRequest) ProtoMessage() {}
+
+func (x *BatchGetRocketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetRocketsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetRocketsRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchGetRocketsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Response message for getting several rockets, one result per requested ID
+type BatchGetRocketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*RocketResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetRocketsResponse) Reset() {
+	*x = BatchGetRocketsResponse{}
+	mi := &file_lib_grpc_space_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetRocketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetRocketsResponse) ProtoMessage() {}
+
+func (x *BatchGetRocketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetRocketsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetRocketsResponse) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetRocketsResponse) GetResults() []*RocketResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Result for one ID of a BatchGetRockets call; exactly one of rocket and
+// error is set
+type RocketResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rocket        *Rocket                `protobuf:"bytes,2,opt,name=rocket,proto3" json:"rocket,omitempty"`
+	Error         *RocketError           `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RocketResult) Reset() {
+	*x = RocketResult{}
+	mi := &file_lib_grpc_space_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RocketResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RocketResult) ProtoMessage() {}
+
+func (x *RocketResult) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RocketResult.ProtoReflect.Descriptor instead.
+func (*RocketResult) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RocketResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RocketResult) GetRocket() *Rocket {
+	if x != nil {
+		return x.Rocket
+	}
+	return nil
+}
+
+func (x *RocketResult) GetError() *RocketError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Why a rocket could not be fetched, matching the status GetRocket would
+// have failed with
+type RocketError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// google.rpc.Code of the failure
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// ErrorInfo reason, e.g. UPSTREAM_NOT_FOUND
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Upstream      string `protobuf:"bytes,4,opt,name=upstream,proto3" json:"upstream,omitempty"`
+	Retryable     bool   `protobuf:"varint,5,opt,name=retryable,proto3" json:"retryable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RocketError) Reset() {
+	*x = RocketError{}
+	mi := &file_lib_grpc_space_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RocketError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RocketError) ProtoMessage() {}
+
+func (x *RocketError) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RocketError.ProtoReflect.Descriptor instead.
+func (*RocketError) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RocketError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RocketError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RocketError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RocketError) GetUpstream() string {
+	if x != nil {
+		return x.Upstream
+	}
+	return ""
+}
+
+func (x *RocketError) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+// Request message for getting all capsules
+type GetCapsulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapsulesRequest) Reset() {
+	*x = GetCapsulesRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapsulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapsulesRequest) ProtoMessage() {}
+
+func (x *GetCapsulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapsulesRequest.ProtoReflect.Descriptor instead.
+func (*GetCapsulesRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{14}
+}
+
+// Response message for getting all capsules
+type GetCapsulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Capsules      []*Capsule             `protobuf:"bytes,1,rep,name=capsules,proto3" json:"capsules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapsulesResponse) Reset() {
+	*x = GetCapsulesResponse{}
+	mi := &file_lib_grpc_space_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapsulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapsulesResponse) ProtoMessage() {}
+
+func (x *GetCapsulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapsulesResponse.ProtoReflect.Descriptor instead.
+func (*GetCapsulesResponse) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCapsulesResponse) GetCapsules() []*Capsule {
+	if x != nil {
+		return x.Capsules
+	}
+	return nil
+}
+
+// Request message for getting a specific capsule
+type GetCapsuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapsuleRequest) Reset() {
+	*x = GetCapsuleRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapsuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapsuleRequest) ProtoMessage() {}
+
+func (x *GetCapsuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapsuleRequest.ProtoReflect.Descriptor instead.
+func (*GetCapsuleRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCapsuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request message for getting all cores
+type GetCoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoresRequest) Reset() {
+	*x = GetCoresRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoresRequest) ProtoMessage() {}
+
+func (x *GetCoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoresRequest.ProtoReflect.Descriptor instead.
+func (*GetCoresRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{17}
+}
+
+// Response message for getting all cores
+type GetCoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cores         []*Core                `protobuf:"bytes,1,rep,name=cores,proto3" json:"cores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoresResponse) Reset() {
+	*x = GetCoresResponse{}
+	mi := &file_lib_grpc_space_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoresResponse) ProtoMessage() {}
+
+func (x *GetCoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoresResponse.ProtoReflect.Descriptor instead.
+func (*GetCoresResponse) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCoresResponse) GetCores() []*Core {
+	if x != nil {
+		return x.Cores
+	}
+	return nil
+}
+
+// Request message for getting all crew members
+type GetCrewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCrewRequest) Reset() {
+	*x = GetCrewRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrewRequest) ProtoMessage() {}
+
+func (x *GetCrewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrewRequest.ProtoReflect.Descriptor instead.
+func (*GetCrewRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{19}
+}
+
+// Response message for getting all crew members
+type GetCrewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Crew          []*CrewMember          `protobuf:"bytes,1,rep,name=crew,proto3" json:"crew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCrewResponse) Reset() {
+	*x = GetCrewResponse{}
+	mi := &file_lib_grpc_space_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCrewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCrewResponse) ProtoMessage() {}
+
+func (x *GetCrewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCrewResponse.ProtoReflect.Descriptor instead.
+func (*GetCrewResponse) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCrewResponse) GetCrew() []*CrewMember {
+	if x != nil {
+		return x.Crew
+	}
+	return nil
+}
+
+// A point on the globe in degrees
+type LatLng struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatLng) Reset() {
+	*x = LatLng{}
+	mi := &file_lib_grpc_space_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatLng) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatLng) ProtoMessage() {}
+
+func (x *LatLng) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatLng.ProtoReflect.Descriptor instead.
+func (*LatLng) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LatLng) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *LatLng) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// Request message for getting launchpads
+type GetLaunchpadsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When set, only launchpads within radius_km of this point are returned,
+	// closest first, each with its distance_km
+	Near *LatLng `protobuf:"bytes,1,opt,name=near,proto3" json:"near,omitempty"`
+	// 0 keeps all launchpads; only allowed together with near
+	RadiusKm      float64 `protobuf:"fixed64,2,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLaunchpadsRequest) Reset() {
+	*x = GetLaunchpadsRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLaunchpadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLaunchpadsRequest) ProtoMessage() {}
+
+func (x *GetLaunchpadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLaunchpadsRequest.ProtoReflect.Descriptor instead.
+func (*GetLaunchpadsRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetLaunchpadsRequest) GetNear() *LatLng {
+	if x != nil {
+		return x.Near
+	}
+	return nil
+}
+
+func (x *GetLaunchpadsRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+// Response message for getting launchpads
+type GetLaunchpadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Launchpads    []*Launchpad           `protobuf:"bytes,1,rep,name=launchpads,proto3" json:"launchpads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLaunchpadsResponse) Reset() {
+	*x = GetLaunchpadsResponse{}
+	mi := &file_lib_grpc_space_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLaunchpadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLaunchpadsResponse) ProtoMessage() {}
+
+func (x *GetLaunchpadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLaunchpadsResponse.ProtoReflect.Descriptor instead.
+func (*GetLaunchpadsResponse) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetLaunchpadsResponse) GetLaunchpads() []*Launchpad {
+	if x != nil {
+		return x.Launchpads
+	}
+	return nil
+}
+
+// Request message for getting a specific launchpad
+type GetLaunchpadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLaunchpadRequest) Reset() {
+	*x = GetLaunchpadRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLaunchpadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLaunchpadRequest) ProtoMessage() {}
+
+func (x *GetLaunchpadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLaunchpadRequest.ProtoReflect.Descriptor instead.
+func (*GetLaunchpadRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetLaunchpadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Request message for getting all landpads
+type GetLandpadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLandpadsRequest) Reset() {
+	*x = GetLandpadsRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLandpadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandpadsRequest) ProtoMessage() {}
+
+func (x *GetLandpadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandpadsRequest.ProtoReflect.Descriptor instead.
+func (*GetLandpadsRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{25}
+}
+
+// Response message for getting all landpads
+type GetLandpadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Landpads      []*Landpad             `protobuf:"bytes,1,rep,name=landpads,proto3" json:"landpads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLandpadsResponse) Reset() {
+	*x = GetLandpadsResponse{}
+	mi := &file_lib_grpc_space_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLandpadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLandpadsResponse) ProtoMessage() {}
+
+func (x *GetLandpadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLandpadsResponse.ProtoReflect.Descriptor instead.
+func (*GetLandpadsResponse) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetLandpadsResponse) GetLandpads() []*Landpad {
+	if x != nil {
+		return x.Landpads
+	}
+	return nil
+}
+
+// Request message for getting a math fact
+type GetMathFactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMathFactRequest) Reset() {
+	*x = GetMathFactRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMathFactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMathFactRequest) ProtoMessage() {}
+
+func (x *GetMathFactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMathFactRequest.ProtoReflect.Descriptor instead.
+func (*GetMathFactRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{27}
+}
+
+// Request message for getting NASA's Astronomy Picture of the Day
+type GetAPODRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Day of the picture as YYYY-MM-DD; today's picture when empty
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAPODRequest) Reset() {
+	*x = GetAPODRequest{}
+	mi := &file_lib_grpc_space_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAPODRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPODRequest) ProtoMessage() {}
+
+func (x *GetAPODRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPODRequest.ProtoReflect.Descriptor instead.
+func (*GetAPODRequest) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetAPODRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// Response message containing launch details
+type Launch struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	FlightNumber int32                  `protobuf:"varint,1,opt,name=flight_number,json=flightNumber,proto3" json:"flight_number,omitempty"`
+	MissionName  string                 `protobuf:"bytes,2,opt,name=mission_name,json=missionName,proto3" json:"mission_name,omitempty"`
+	DateUtc      string                 `protobuf:"bytes,3,opt,name=date_utc,json=dateUtc,proto3" json:"date_utc,omitempty"`
+	// Unset until the outcome of the launch is known
+	Success  *bool  `protobuf:"varint,4,opt,name=success,proto3,oneof" json:"success,omitempty"`
+	Details  string `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+	Id       string `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+	RocketId string `protobuf:"bytes,7,opt,name=rocket_id,json=rocketId,proto3" json:"rocket_id,omitempty"`
+	Upcoming bool   `protobuf:"varint,8,opt,name=upcoming,proto3" json:"upcoming,omitempty"`
+	// How precisely date_utc is known: half, quarter, year, month, day or hour
+	DatePrecision string `protobuf:"bytes,9,opt,name=date_precision,json=datePrecision,proto3" json:"date_precision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Launch) Reset() {
+	*x = Launch{}
+	mi := &file_lib_grpc_space_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Launch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Launch) ProtoMessage() {}
+
+func (x *Launch) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Launch.ProtoReflect.Descriptor instead.
+func (*Launch) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Launch) GetFlightNumber() int32 {
+	if x != nil {
+		return x.FlightNumber
+	}
+	return 0
+}
+
+func (x *Launch) GetMissionName() string {
+	if x != nil {
+		return x.MissionName
+	}
+	return ""
+}
+
+func (x *Launch) GetDateUtc() string {
+	if x != nil {
+		return x.DateUtc
+	}
+	return ""
+}
+
+func (x *Launch) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *Launch) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Launch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Launch) GetRocketId() string {
+	if x != nil {
+		return x.RocketId
+	}
+	return ""
+}
+
+func (x *Launch) GetUpcoming() bool {
+	if x != nil {
+		return x.Upcoming
+	}
+	return false
+}
+
+func (x *Launch) GetDatePrecision() string {
+	if x != nil {
+		return x.DatePrecision
+	}
+	return ""
+}
+
+// Response message containing all details of a launch
+type LaunchDetail struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FlightNumber int32                  `protobuf:"varint,2,opt,name=flight_number,json=flightNumber,proto3" json:"flight_number,omitempty"`
+	MissionName  string                 `protobuf:"bytes,3,opt,name=mission_name,json=missionName,proto3" json:"mission_name,omitempty"`
+	DateUtc      string                 `protobuf:"bytes,4,opt,name=date_utc,json=dateUtc,proto3" json:"date_utc,omitempty"`
+	// How precisely the date is known: half, quarter, year, month, day or hour
+	DatePrecision string `protobuf:"bytes,5,opt,name=date_precision,json=datePrecision,proto3" json:"date_precision,omitempty"`
+	// Unset until the outcome of the launch is known
+	Success     *bool            `protobuf:"varint,6,opt,name=success,proto3,oneof" json:"success,omitempty"`
+	Upcoming    bool             `protobuf:"varint,7,opt,name=upcoming,proto3" json:"upcoming,omitempty"`
+	Details     string           `protobuf:"bytes,8,opt,name=details,proto3" json:"details,omitempty"`
+	Links       *LaunchLinks     `protobuf:"bytes,9,opt,name=links,proto3" json:"links,omitempty"`
+	Failures    []*LaunchFailure `protobuf:"bytes,10,rep,name=failures,proto3" json:"failures,omitempty"`
+	Cores       []*LaunchCore    `protobuf:"bytes,11,rep,name=cores,proto3" json:"cores,omitempty"`
+	RocketId    string           `protobuf:"bytes,12,opt,name=rocket_id,json=rocketId,proto3" json:"rocket_id,omitempty"`
+	LaunchpadId string           `protobuf:"bytes,13,opt,name=launchpad_id,json=launchpadId,proto3" json:"launchpad_id,omitempty"`
+	PayloadIds  []string         `protobuf:"bytes,14,rep,name=payload_ids,json=payloadIds,proto3" json:"payload_ids,omitempty"`
+	CrewIds     []string         `protobuf:"bytes,15,rep,name=crew_ids,json=crewIds,proto3" json:"crew_ids,omitempty"`
+	// The referenced documents, only set when populate was requested
+	Rocket        *Rocket       `protobuf:"bytes,16,opt,name=rocket,proto3" json:"rocket,omitempty"`
+	Launchpad     *Launchpad    `protobuf:"bytes,17,opt,name=launchpad,proto3" json:"launchpad,omitempty"`
+	Payloads      []*Payload    `protobuf:"bytes,18,rep,name=payloads,proto3" json:"payloads,omitempty"`
+	Crew          []*CrewMember `protobuf:"bytes,19,rep,name=crew,proto3" json:"crew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaunchDetail) Reset() {
+	*x = LaunchDetail{}
+	mi := &file_lib_grpc_space_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaunchDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchDetail) ProtoMessage() {}
+
+func (x *LaunchDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaunchDetail.ProtoReflect.Descriptor instead.
+func (*LaunchDetail) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LaunchDetail) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LaunchDetail) GetFlightNumber() int32 {
+	if x != nil {
+		return x.FlightNumber
+	}
+	return 0
+}
+
+func (x *LaunchDetail) GetMissionName() string {
+	if x != nil {
+		return x.MissionName
+	}
+	return ""
+}
+
+func (x *LaunchDetail) GetDateUtc() string {
+	if x != nil {
+		return x.DateUtc
+	}
+	return ""
+}
+
+func (x *LaunchDetail) GetDatePrecision() string {
+	if x != nil {
+		return x.DatePrecision
+	}
+	return ""
+}
+
+func (x *LaunchDetail) GetSuccess() bool {
+	if x != nil && x.Success != nil {
+		return *x.Success
+	}
+	return false
+}
+
+func (x *LaunchDetail) GetUpcoming() bool {
+	if x != nil {
+		return x.Upcoming
+	}
+	return false
+}
+
+func (x *LaunchDetail) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *LaunchDetail) GetLinks() *LaunchLinks {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *LaunchDetail) GetFailures() []*LaunchFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *LaunchDetail) GetCores() []*LaunchCore {
+	if x != nil {
+		return x.Cores
+	}
+	return nil
+}
+
+func (x *LaunchDetail) GetRocketId() string {
+	if x != nil {
+		return x.RocketId
+	}
+	return ""
+}
+
+func (x *LaunchDetail) GetLaunchpadId() string {
+	if x != nil {
+		return x.LaunchpadId
+	}
+	return ""
+}
+
+func (x *LaunchDetail) GetPayloadIds() []string {
+	if x != nil {
+		return x.PayloadIds
+	}
+	return nil
+}
+
+func (x *LaunchDetail) GetCrewIds() []string {
+	if x != nil {
+		return x.CrewIds
+	}
+	return nil
+}
+
+func (x *LaunchDetail) GetRocket() *Rocket {
+	if x != nil {
+		return x.Rocket
+	}
+	return nil
+}
+
+func (x *LaunchDetail) GetLaunchpad() *Launchpad {
+	if x != nil {
+		return x.Launchpad
+	}
+	return nil
+}
+
+func (x *LaunchDetail) GetPayloads() []*Payload {
+	if x != nil {
+		return x.Payloads
+	}
+	return nil
+}
+
+func (x *LaunchDetail) GetCrew() []*CrewMember {
+	if x != nil {
+		return x.Crew
+	}
+	return nil
+}
+
+// Media about a launch; links SpaceX does not have are empty
+type LaunchLinks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatchSmall    string                 `protobuf:"bytes,1,opt,name=patch_small,json=patchSmall,proto3" json:"patch_small,omitempty"`
+	PatchLarge    string                 `protobuf:"bytes,2,opt,name=patch_large,json=patchLarge,proto3" json:"patch_large,omitempty"`
+	Webcast       string                 `protobuf:"bytes,3,opt,name=webcast,proto3" json:"webcast,omitempty"`
+	YoutubeId     string                 `protobuf:"bytes,4,opt,name=youtube_id,json=youtubeId,proto3" json:"youtube_id,omitempty"`
+	Article       string                 `protobuf:"bytes,5,opt,name=article,proto3" json:"article,omitempty"`
+	Wikipedia     string                 `protobuf:"bytes,6,opt,name=wikipedia,proto3" json:"wikipedia,omitempty"`
+	Presskit      string                 `protobuf:"bytes,7,opt,name=presskit,proto3" json:"presskit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaunchLinks) Reset() {
+	*x = LaunchLinks{}
+	mi := &file_lib_grpc_space_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaunchLinks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchLinks) ProtoMessage() {}
+
+func (x *LaunchLinks) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaunchLinks.ProtoReflect.Descriptor instead.
+func (*LaunchLinks) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LaunchLinks) GetPatchSmall() string {
+	if x != nil {
+		return x.PatchSmall
+	}
+	return ""
+}
+
+func (x *LaunchLinks) GetPatchLarge() string {
+	if x != nil {
+		return x.PatchLarge
+	}
+	return ""
+}
+
+func (x *LaunchLinks) GetWebcast() string {
+	if x != nil {
+		return x.Webcast
+	}
+	return ""
+}
+
+func (x *LaunchLinks) GetYoutubeId() string {
+	if x != nil {
+		return x.YoutubeId
+	}
+	return ""
+}
+
+func (x *LaunchLinks) GetArticle() string {
+	if x != nil {
+		return x.Article
+	}
+	return ""
+}
+
+func (x *LaunchLinks) GetWikipedia() string {
+	if x != nil {
+		return x.Wikipedia
+	}
+	return ""
+}
+
+func (x *LaunchLinks) GetPresskit() string {
+	if x != nil {
+		return x.Presskit
+	}
+	return ""
+}
+
+// What went wrong during a failed launch
+type LaunchFailure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Seconds after liftoff
+	Time          int32  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Altitude      int32  `protobuf:"varint,2,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaunchFailure) Reset() {
+	*x = LaunchFailure{}
+	mi := &file_lib_grpc_space_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaunchFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchFailure) ProtoMessage() {}
+
+func (x *LaunchFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaunchFailure.ProtoReflect.Descriptor instead.
+func (*LaunchFailure) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LaunchFailure) GetTime() int32 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *LaunchFailure) GetAltitude() int32 {
+	if x != nil {
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *LaunchFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// A first stage core flown on a launch and how its landing went
+type LaunchCore struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CoreId         string                 `protobuf:"bytes,1,opt,name=core_id,json=coreId,proto3" json:"core_id,omitempty"`
+	Flight         int32                  `protobuf:"varint,2,opt,name=flight,proto3" json:"flight,omitempty"`
+	Gridfins       bool                   `protobuf:"varint,3,opt,name=gridfins,proto3" json:"gridfins,omitempty"`
+	Legs           bool                   `protobuf:"varint,4,opt,name=legs,proto3" json:"legs,omitempty"`
+	Reused         bool                   `protobuf:"varint,5,opt,name=reused,proto3" json:"reused,omitempty"`
+	LandingAttempt bool                   `protobuf:"varint,6,opt,name=landing_attempt,json=landingAttempt,proto3" json:"landing_attempt,omitempty"`
+	LandingSuccess *bool                  `protobuf:"varint,7,opt,name=landing_success,json=landingSuccess,proto3,oneof" json:"landing_success,omitempty"`
+	LandingType    string                 `protobuf:"bytes,8,opt,name=landing_type,json=landingType,proto3" json:"landing_type,omitempty"`
+	LandpadId      string                 `protobuf:"bytes,9,opt,name=landpad_id,json=landpadId,proto3" json:"landpad_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LaunchCore) Reset() {
+	*x = LaunchCore{}
+	mi := &file_lib_grpc_space_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaunchCore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchCore) ProtoMessage() {}
+
+func (x *LaunchCore) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaunchCore.ProtoReflect.Descriptor instead.
+func (*LaunchCore) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LaunchCore) GetCoreId() string {
+	if x != nil {
+		return x.CoreId
+	}
+	return ""
+}
+
+func (x *LaunchCore) GetFlight() int32 {
+	if x != nil {
+		return x.Flight
+	}
+	return 0
+}
+
+func (x *LaunchCore) GetGridfins() bool {
+	if x != nil {
+		return x.Gridfins
+	}
+	return false
+}
+
+func (x *LaunchCore) GetLegs() bool {
+	if x != nil {
+		return x.Legs
+	}
+	return false
+}
+
+func (x *LaunchCore) GetReused() bool {
+	if x != nil {
+		return x.Reused
+	}
+	return false
+}
+
+func (x *LaunchCore) GetLandingAttempt() bool {
+	if x != nil {
+		return x.LandingAttempt
+	}
+	return false
+}
+
+func (x *LaunchCore) GetLandingSuccess() bool {
+	if x != nil && x.LandingSuccess != nil {
+		return *x.LandingSuccess
+	}
+	return false
+}
+
+func (x *LaunchCore) GetLandingType() string {
+	if x != nil {
+		return x.LandingType
+	}
+	return ""
+}
+
+func (x *LaunchCore) GetLandpadId() string {
+	if x != nil {
+		return x.LandpadId
+	}
+	return ""
+}
+
+// A site SpaceX launches from
+type Launchpad struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FullName  string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Locality  string                 `protobuf:"bytes,4,opt,name=locality,proto3" json:"locality,omitempty"`
+	Region    string                 `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,7,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// active, inactive, unknown, retired, lost or under construction
+	Status          string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	LaunchAttempts  int32  `protobuf:"varint,9,opt,name=launch_attempts,json=launchAttempts,proto3" json:"launch_attempts,omitempty"`
+	LaunchSuccesses int32  `protobuf:"varint,10,opt,name=launch_successes,json=launchSuccesses,proto3" json:"launch_successes,omitempty"`
+	// Great-circle distance from the point a GetLaunchpads call asked for
+	DistanceKm    *float64 `protobuf:"fixed64,11,opt,name=distance_km,json=distanceKm,proto3,oneof" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Launchpad) Reset() {
+	*x = Launchpad{}
+	mi := &file_lib_grpc_space_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Launchpad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Launchpad) ProtoMessage() {}
+
+func (x *Launchpad) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Launchpad.ProtoReflect.Descriptor instead.
+func (*Launchpad) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Launchpad) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Launchpad) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Launchpad) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Launchpad) GetLocality() string {
+	if x != nil {
+		return x.Locality
+	}
+	return ""
+}
+
+func (x *Launchpad) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Launchpad) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Launchpad) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Launchpad) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Launchpad) GetLaunchAttempts() int32 {
+	if x != nil {
+		return x.LaunchAttempts
+	}
+	return 0
+}
+
+func (x *Launchpad) GetLaunchSuccesses() int32 {
+	if x != nil {
+		return x.LaunchSuccesses
+	}
+	return 0
+}
+
+func (x *Launchpad) GetDistanceKm() float64 {
+	if x != nil && x.DistanceKm != nil {
+		return *x.DistanceKm
+	}
+	return 0
+}
+
+// A landing zone or drone ship SpaceX lands first stages on
+type Landpad struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FullName string                 `protobuf:"bytes,3,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	// RTLS for landing zones, ASDS for drone ships
+	Type             string  `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Locality         string  `protobuf:"bytes,5,opt,name=locality,proto3" json:"locality,omitempty"`
+	Region           string  `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	Latitude         float64 `protobuf:"fixed64,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude        float64 `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	LandingAttempts  int32   `protobuf:"varint,9,opt,name=landing_attempts,json=landingAttempts,proto3" json:"landing_attempts,omitempty"`
+	LandingSuccesses int32   `protobuf:"varint,10,opt,name=landing_successes,json=landingSuccesses,proto3" json:"landing_successes,omitempty"`
+	// active, inactive, unknown, retired, lost or under construction
+	Status        string `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Landpad) Reset() {
+	*x = Landpad{}
+	mi := &file_lib_grpc_space_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Landpad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Landpad) ProtoMessage() {}
+
+func (x *Landpad) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Landpad.ProtoReflect.Descriptor instead.
+func (*Landpad) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Landpad) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Landpad) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Landpad) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *Landpad) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Landpad) GetLocality() string {
+	if x != nil {
+		return x.Locality
+	}
+	return ""
+}
+
+func (x *Landpad) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Landpad) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Landpad) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Landpad) GetLandingAttempts() int32 {
+	if x != nil {
+		return x.LandingAttempts
+	}
+	return 0
+}
+
+func (x *Landpad) GetLandingSuccesses() int32 {
+	if x != nil {
+		return x.LandingSuccesses
+	}
+	return 0
+}
+
+func (x *Landpad) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Something a launch carried to orbit
+type Payload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Reused        bool                   `protobuf:"varint,4,opt,name=reused,proto3" json:"reused,omitempty"`
+	Customers     []string               `protobuf:"bytes,5,rep,name=customers,proto3" json:"customers,omitempty"`
+	Orbit         string                 `protobuf:"bytes,6,opt,name=orbit,proto3" json:"orbit,omitempty"`
+	MassKg        float64                `protobuf:"fixed64,7,opt,name=mass_kg,json=massKg,proto3" json:"mass_kg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	mi := &file_lib_grpc_space_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Payload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payload) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Payload) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Payload) GetReused() bool {
+	if x != nil {
+		return x.Reused
+	}
+	return false
+}
+
+func (x *Payload) GetCustomers() []string {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *Payload) GetOrbit() string {
+	if x != nil {
+		return x.Orbit
+	}
+	return ""
+}
+
+func (x *Payload) GetMassKg() float64 {
+	if x != nil {
+		return x.MassKg
+	}
+	return 0
+}
+
+// An astronaut who flew on a SpaceX launch
+type CrewMember struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Agency    string                 `protobuf:"bytes,3,opt,name=agency,proto3" json:"agency,omitempty"`
+	Image     string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	Wikipedia string                 `protobuf:"bytes,5,opt,name=wikipedia,proto3" json:"wikipedia,omitempty"`
+	// active, inactive, retired or unknown
+	Status        string   `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	LaunchIds     []string `protobuf:"bytes,7,rep,name=launch_ids,json=launchIds,proto3" json:"launch_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrewMember) Reset() {
+	*x = CrewMember{}
+	mi := &file_lib_grpc_space_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrewMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrewMember) ProtoMessage() {}
+
+func (x *CrewMember) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -91,78 +2382,92 @@ func (x *GetRocketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRocketRequest.ProtoReflect.Descriptor instead.
-func (*GetRocketRequest) Descriptor() ([]byte, []int) {
-	return file_lib_grpc_space_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CrewMember.ProtoReflect.Descriptor instead.
+func (*CrewMember) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetRocketRequest) GetId() string {
+func (x *CrewMember) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// Request message for getting all rockets
-type GetRocketsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CrewMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetRocketsRequest) Reset() {
-	*x = GetRocketsRequest{}
-	mi := &file_lib_grpc_space_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CrewMember) GetAgency() string {
+	if x != nil {
+		return x.Agency
+	}
+	return ""
 }
 
-func (x *GetRocketsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CrewMember) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
 }
 
-func (*GetRocketsRequest) ProtoMessage() {}
+func (x *CrewMember) GetWikipedia() string {
+	if x != nil {
+		return x.Wikipedia
+	}
+	return ""
+}
 
-func (x *GetRocketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lib_grpc_space_proto_msgTypes[2]
+func (x *CrewMember) GetStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetRocketsRequest.ProtoReflect.Descriptor instead.
-func (*GetRocketsRequest) Descriptor() ([]byte, []int) {
-	return file_lib_grpc_space_proto_rawDescGZIP(), []int{2}
+func (x *CrewMember) GetLaunchIds() []string {
+	if x != nil {
+		return x.LaunchIds
+	}
+	return nil
 }
 
-// Response message for getting all rockets
-type GetRocketsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rockets       []*RocketSummary       `protobuf:"bytes,1,rep,name=rockets,proto3" json:"rockets,omitempty"`
+// A Dragon capsule and how often it has flown
+type Capsule struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Serial string                 `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	// active, retired, unknown or destroyed
+	Status        string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Type          string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	ReuseCount    int32    `protobuf:"varint,5,opt,name=reuse_count,json=reuseCount,proto3" json:"reuse_count,omitempty"`
+	WaterLandings int32    `protobuf:"varint,6,opt,name=water_landings,json=waterLandings,proto3" json:"water_landings,omitempty"`
+	LandLandings  int32    `protobuf:"varint,7,opt,name=land_landings,json=landLandings,proto3" json:"land_landings,omitempty"`
+	LastUpdate    string   `protobuf:"bytes,8,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	LaunchIds     []string `protobuf:"bytes,9,rep,name=launch_ids,json=launchIds,proto3" json:"launch_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRocketsResponse) Reset() {
-	*x = GetRocketsResponse{}
-	mi := &file_lib_grpc_space_proto_msgTypes[3]
+func (x *Capsule) Reset() {
+	*x = Capsule{}
+	mi := &file_lib_grpc_space_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRocketsResponse) String() string {
+func (x *Capsule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRocketsResponse) ProtoMessage() {}
+func (*Capsule) ProtoMessage() {}
 
-func (x *GetRocketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lib_grpc_space_proto_msgTypes[3]
+func (x *Capsule) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,82 +2478,109 @@ func (x *GetRocketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRocketsResponse.ProtoReflect.Descriptor instead.
-func (*GetRocketsResponse) Descriptor() ([]byte, []int) {
-	return file_lib_grpc_space_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Capsule.ProtoReflect.Descriptor instead.
+func (*Capsule) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetRocketsResponse) GetRockets() []*RocketSummary {
+func (x *Capsule) GetId() string {
 	if x != nil {
-		return x.Rockets
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// Request message for getting a math fact
-type GetMathFactRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Capsule) GetSerial() string {
+	if x != nil {
+		return x.Serial
+	}
+	return ""
 }
 
-func (x *GetMathFactRequest) Reset() {
-	*x = GetMathFactRequest{}
-	mi := &file_lib_grpc_space_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Capsule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *GetMathFactRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Capsule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (*GetMathFactRequest) ProtoMessage() {}
+func (x *Capsule) GetReuseCount() int32 {
+	if x != nil {
+		return x.ReuseCount
+	}
+	return 0
+}
 
-func (x *GetMathFactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lib_grpc_space_proto_msgTypes[4]
+func (x *Capsule) GetWaterLandings() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.WaterLandings
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetMathFactRequest.ProtoReflect.Descriptor instead.
-func (*GetMathFactRequest) Descriptor() ([]byte, []int) {
-	return file_lib_grpc_space_proto_rawDescGZIP(), []int{4}
+func (x *Capsule) GetLandLandings() int32 {
+	if x != nil {
+		return x.LandLandings
+	}
+	return 0
 }
 
-// Response message containing launch details
-type Launch struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FlightNumber  int32                  `protobuf:"varint,1,opt,name=flight_number,json=flightNumber,proto3" json:"flight_number,omitempty"`
-	MissionName   string                 `protobuf:"bytes,2,opt,name=mission_name,json=missionName,proto3" json:"mission_name,omitempty"`
-	DateUtc       string                 `protobuf:"bytes,3,opt,name=date_utc,json=dateUtc,proto3" json:"date_utc,omitempty"`
-	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`
+func (x *Capsule) GetLastUpdate() string {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return ""
+}
+
+func (x *Capsule) GetLaunchIds() []string {
+	if x != nil {
+		return x.LaunchIds
+	}
+	return nil
+}
+
+// A first stage booster and its landing record
+type Core struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Serial string                 `protobuf:"bytes,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	// Falcon 9 block version, 0 when unknown
+	Block int32 `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	// active, inactive, unknown, expended, lost or retired
+	Status        string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ReuseCount    int32    `protobuf:"varint,5,opt,name=reuse_count,json=reuseCount,proto3" json:"reuse_count,omitempty"`
+	RtlsAttempts  int32    `protobuf:"varint,6,opt,name=rtls_attempts,json=rtlsAttempts,proto3" json:"rtls_attempts,omitempty"`
+	RtlsLandings  int32    `protobuf:"varint,7,opt,name=rtls_landings,json=rtlsLandings,proto3" json:"rtls_landings,omitempty"`
+	AsdsAttempts  int32    `protobuf:"varint,8,opt,name=asds_attempts,json=asdsAttempts,proto3" json:"asds_attempts,omitempty"`
+	AsdsLandings  int32    `protobuf:"varint,9,opt,name=asds_landings,json=asdsLandings,proto3" json:"asds_landings,omitempty"`
+	LastUpdate    string   `protobuf:"bytes,10,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	LaunchIds     []string `protobuf:"bytes,11,rep,name=launch_ids,json=launchIds,proto3" json:"launch_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Launch) Reset() {
-	*x = Launch{}
-	mi := &file_lib_grpc_space_proto_msgTypes[5]
+func (x *Core) Reset() {
+	*x = Core{}
+	mi := &file_lib_grpc_space_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Launch) String() string {
+func (x *Core) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Launch) ProtoMessage() {}
+func (*Core) ProtoMessage() {}
 
-func (x *Launch) ProtoReflect() protoreflect.Message {
-	mi := &file_lib_grpc_space_proto_msgTypes[5]
+func (x *Core) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,46 +2591,88 @@ func (x *Launch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Launch.ProtoReflect.Descriptor instead.
-func (*Launch) Descriptor() ([]byte, []int) {
-	return file_lib_grpc_space_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Core.ProtoReflect.Descriptor instead.
+func (*Core) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *Launch) GetFlightNumber() int32 {
+func (x *Core) GetId() string {
 	if x != nil {
-		return x.FlightNumber
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *Launch) GetMissionName() string {
+func (x *Core) GetSerial() string {
 	if x != nil {
-		return x.MissionName
+		return x.Serial
 	}
 	return ""
 }
 
-func (x *Launch) GetDateUtc() string {
+func (x *Core) GetBlock() int32 {
 	if x != nil {
-		return x.DateUtc
+		return x.Block
+	}
+	return 0
+}
+
+func (x *Core) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Launch) GetSuccess() bool {
+func (x *Core) GetReuseCount() int32 {
 	if x != nil {
-		return x.Success
+		return x.ReuseCount
 	}
-	return false
+	return 0
 }
 
-func (x *Launch) GetDetails() string {
+func (x *Core) GetRtlsAttempts() int32 {
 	if x != nil {
-		return x.Details
+		return x.RtlsAttempts
+	}
+	return 0
+}
+
+func (x *Core) GetRtlsLandings() int32 {
+	if x != nil {
+		return x.RtlsLandings
+	}
+	return 0
+}
+
+func (x *Core) GetAsdsAttempts() int32 {
+	if x != nil {
+		return x.AsdsAttempts
+	}
+	return 0
+}
+
+func (x *Core) GetAsdsLandings() int32 {
+	if x != nil {
+		return x.AsdsLandings
+	}
+	return 0
+}
+
+func (x *Core) GetLastUpdate() string {
+	if x != nil {
+		return x.LastUpdate
 	}
 	return ""
 }
 
+func (x *Core) GetLaunchIds() []string {
+	if x != nil {
+		return x.LaunchIds
+	}
+	return nil
+}
+
 // Response message containing rocket details
 type Rocket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -313,7 +2687,7 @@ type Rocket struct {
 
 func (x *Rocket) Reset() {
 	*x = Rocket{}
-	mi := &file_lib_grpc_space_proto_msgTypes[6]
+	mi := &file_lib_grpc_space_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +2699,7 @@ func (x *Rocket) String() string {
 func (*Rocket) ProtoMessage() {}
 
 func (x *Rocket) ProtoReflect() protoreflect.Message {
-	mi := &file_lib_grpc_space_proto_msgTypes[6]
+	mi := &file_lib_grpc_space_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +2712,7 @@ func (x *Rocket) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rocket.ProtoReflect.Descriptor instead.
 func (*Rocket) Descriptor() ([]byte, []int) {
-	return file_lib_grpc_space_proto_rawDescGZIP(), []int{6}
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *Rocket) GetId() string {
@@ -387,7 +2761,7 @@ type RocketSummary struct {
 
 func (x *RocketSummary) Reset() {
 	*x = RocketSummary{}
-	mi := &file_lib_grpc_space_proto_msgTypes[7]
+	mi := &file_lib_grpc_space_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +2773,7 @@ func (x *RocketSummary) String() string {
 func (*RocketSummary) ProtoMessage() {}
 
 func (x *RocketSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_lib_grpc_space_proto_msgTypes[7]
+	mi := &file_lib_grpc_space_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +2786,7 @@ func (x *RocketSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RocketSummary.ProtoReflect.Descriptor instead.
 func (*RocketSummary) Descriptor() ([]byte, []int) {
-	return file_lib_grpc_space_proto_rawDescGZIP(), []int{7}
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *RocketSummary) GetId() string {
@@ -442,7 +2816,7 @@ type MathFact struct {
 
 func (x *MathFact) Reset() {
 	*x = MathFact{}
-	mi := &file_lib_grpc_space_proto_msgTypes[8]
+	mi := &file_lib_grpc_space_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -454,7 +2828,7 @@ func (x *MathFact) String() string {
 func (*MathFact) ProtoMessage() {}
 
 func (x *MathFact) ProtoReflect() protoreflect.Message {
-	mi := &file_lib_grpc_space_proto_msgTypes[8]
+	mi := &file_lib_grpc_space_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +2841,7 @@ func (x *MathFact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MathFact.ProtoReflect.Descriptor instead.
 func (*MathFact) Descriptor() ([]byte, []int) {
-	return file_lib_grpc_space_proto_rawDescGZIP(), []int{8}
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *MathFact) GetText() string {
@@ -498,24 +2872,310 @@ func (x *MathFact) GetType() string {
 	return ""
 }
 
+// Response message containing NASA's Astronomy Picture of the Day
+type APOD struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Date           string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Explanation    string                 `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Url            string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	MediaType      string                 `protobuf:"bytes,5,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	ServiceVersion string                 `protobuf:"bytes,6,opt,name=service_version,json=serviceVersion,proto3" json:"service_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *APOD) Reset() {
+	*x = APOD{}
+	mi := &file_lib_grpc_space_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APOD) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APOD) ProtoMessage() {}
+
+func (x *APOD) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_grpc_space_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APOD.ProtoReflect.Descriptor instead.
+func (*APOD) Descriptor() ([]byte, []int) {
+	return file_lib_grpc_space_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *APOD) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *APOD) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *APOD) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *APOD) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *APOD) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *APOD) GetServiceVersion() string {
+	if x != nil {
+		return x.ServiceVersion
+	}
+	return ""
+}
+
 var File_lib_grpc_space_proto protoreflect.FileDescriptor
 
 const file_lib_grpc_space_proto_rawDesc = "" +
 	"\n" +
 	"\x14lib/grpc/space.proto\x12\x05space\"\x15\n" +
-	"\x13LatestLaunchRequest\"\"\n" +
+	"\x13LatestLaunchRequest\"\x1a\n" +
+	"\x18WatchLatestLaunchRequest\"\x16\n" +
+	"\x14GetNextLaunchRequest\"j\n" +
+	"\x0fLaunchCountdown\x12%\n" +
+	"\x06launch\x18\x01 \x01(\v2\r.space.LaunchR\x06launch\x120\n" +
+	"\x14seconds_until_launch\x18\x02 \x01(\x03R\x12secondsUntilLaunch\">\n" +
+	"\x10GetLaunchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bpopulate\x18\x02 \x01(\bR\bpopulate\"\x89\x02\n" +
+	"\x13ListLaunchesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x1d\n" +
+	"\asuccess\x18\x05 \x01(\bH\x00R\asuccess\x88\x01\x01\x12\x1f\n" +
+	"\bupcoming\x18\x06 \x01(\bH\x01R\bupcoming\x88\x01\x01\x12\x1b\n" +
+	"\trocket_id\x18\a \x01(\tR\brocketId\x12\x1c\n" +
+	"\tascending\x18\b \x01(\bR\tascendingB\n" +
+	"\n" +
+	"\b_successB\v\n" +
+	"\t_upcoming\"\x88\x01\n" +
+	"\x14ListLaunchesResponse\x12)\n" +
+	"\blaunches\x18\x01 \x03(\v2\r.space.LaunchR\blaunches\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\"\n" +
 	"\x10GetRocketRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
 	"\x11GetRocketsRequest\"D\n" +
 	"\x12GetRocketsResponse\x12.\n" +
-	"\arockets\x18\x01 \x03(\v2\x14.space.RocketSummaryR\arockets\"\x14\n" +
-	"\x12GetMathFactRequest\"\x9f\x01\n" +
+	"\arockets\x18\x01 \x03(\v2\x14.space.RocketSummaryR\arockets\"*\n" +
+	"\x16BatchGetRocketsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"H\n" +
+	"\x17BatchGetRocketsResponse\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.space.RocketResultR\aresults\"o\n" +
+	"\fRocketResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x06rocket\x18\x02 \x01(\v2\r.space.RocketR\x06rocket\x12(\n" +
+	"\x05error\x18\x03 \x01(\v2\x12.space.RocketErrorR\x05error\"\x8d\x01\n" +
+	"\vRocketError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bupstream\x18\x04 \x01(\tR\bupstream\x12\x1c\n" +
+	"\tretryable\x18\x05 \x01(\bR\tretryable\"\x14\n" +
+	"\x12GetCapsulesRequest\"A\n" +
+	"\x13GetCapsulesResponse\x12*\n" +
+	"\bcapsules\x18\x01 \x03(\v2\x0e.space.CapsuleR\bcapsules\"#\n" +
+	"\x11GetCapsuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x11\n" +
+	"\x0fGetCoresRequest\"5\n" +
+	"\x10GetCoresResponse\x12!\n" +
+	"\x05cores\x18\x01 \x03(\v2\v.space.CoreR\x05cores\"\x10\n" +
+	"\x0eGetCrewRequest\"8\n" +
+	"\x0fGetCrewResponse\x12%\n" +
+	"\x04crew\x18\x01 \x03(\v2\x11.space.CrewMemberR\x04crew\"B\n" +
+	"\x06LatLng\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"V\n" +
+	"\x14GetLaunchpadsRequest\x12!\n" +
+	"\x04near\x18\x01 \x01(\v2\r.space.LatLngR\x04near\x12\x1b\n" +
+	"\tradius_km\x18\x02 \x01(\x01R\bradiusKm\"I\n" +
+	"\x15GetLaunchpadsResponse\x120\n" +
+	"\n" +
+	"launchpads\x18\x01 \x03(\v2\x10.space.LaunchpadR\n" +
+	"launchpads\"%\n" +
+	"\x13GetLaunchpadRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12GetLandpadsRequest\"A\n" +
+	"\x13GetLandpadsResponse\x12*\n" +
+	"\blandpads\x18\x01 \x03(\v2\x0e.space.LandpadR\blandpads\"\x14\n" +
+	"\x12GetMathFactRequest\"$\n" +
+	"\x0eGetAPODRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\xa0\x02\n" +
 	"\x06Launch\x12#\n" +
 	"\rflight_number\x18\x01 \x01(\x05R\fflightNumber\x12!\n" +
 	"\fmission_name\x18\x02 \x01(\tR\vmissionName\x12\x19\n" +
-	"\bdate_utc\x18\x03 \x01(\tR\adateUtc\x12\x18\n" +
-	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
-	"\adetails\x18\x05 \x01(\tR\adetails\"\x8c\x01\n" +
+	"\bdate_utc\x18\x03 \x01(\tR\adateUtc\x12\x1d\n" +
+	"\asuccess\x18\x04 \x01(\bH\x00R\asuccess\x88\x01\x01\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\x12\x0e\n" +
+	"\x02id\x18\x06 \x01(\tR\x02id\x12\x1b\n" +
+	"\trocket_id\x18\a \x01(\tR\brocketId\x12\x1a\n" +
+	"\bupcoming\x18\b \x01(\bR\bupcoming\x12%\n" +
+	"\x0edate_precision\x18\t \x01(\tR\rdatePrecisionB\n" +
+	"\n" +
+	"\b_success\"\xb4\x05\n" +
+	"\fLaunchDetail\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rflight_number\x18\x02 \x01(\x05R\fflightNumber\x12!\n" +
+	"\fmission_name\x18\x03 \x01(\tR\vmissionName\x12\x19\n" +
+	"\bdate_utc\x18\x04 \x01(\tR\adateUtc\x12%\n" +
+	"\x0edate_precision\x18\x05 \x01(\tR\rdatePrecision\x12\x1d\n" +
+	"\asuccess\x18\x06 \x01(\bH\x00R\asuccess\x88\x01\x01\x12\x1a\n" +
+	"\bupcoming\x18\a \x01(\bR\bupcoming\x12\x18\n" +
+	"\adetails\x18\b \x01(\tR\adetails\x12(\n" +
+	"\x05links\x18\t \x01(\v2\x12.space.LaunchLinksR\x05links\x120\n" +
+	"\bfailures\x18\n" +
+	" \x03(\v2\x14.space.LaunchFailureR\bfailures\x12'\n" +
+	"\x05cores\x18\v \x03(\v2\x11.space.LaunchCoreR\x05cores\x12\x1b\n" +
+	"\trocket_id\x18\f \x01(\tR\brocketId\x12!\n" +
+	"\flaunchpad_id\x18\r \x01(\tR\vlaunchpadId\x12\x1f\n" +
+	"\vpayload_ids\x18\x0e \x03(\tR\n" +
+	"payloadIds\x12\x19\n" +
+	"\bcrew_ids\x18\x0f \x03(\tR\acrewIds\x12%\n" +
+	"\x06rocket\x18\x10 \x01(\v2\r.space.RocketR\x06rocket\x12.\n" +
+	"\tlaunchpad\x18\x11 \x01(\v2\x10.space.LaunchpadR\tlaunchpad\x12*\n" +
+	"\bpayloads\x18\x12 \x03(\v2\x0e.space.PayloadR\bpayloads\x12%\n" +
+	"\x04crew\x18\x13 \x03(\v2\x11.space.CrewMemberR\x04crewB\n" +
+	"\n" +
+	"\b_success\"\xdc\x01\n" +
+	"\vLaunchLinks\x12\x1f\n" +
+	"\vpatch_small\x18\x01 \x01(\tR\n" +
+	"patchSmall\x12\x1f\n" +
+	"\vpatch_large\x18\x02 \x01(\tR\n" +
+	"patchLarge\x12\x18\n" +
+	"\awebcast\x18\x03 \x01(\tR\awebcast\x12\x1d\n" +
+	"\n" +
+	"youtube_id\x18\x04 \x01(\tR\tyoutubeId\x12\x18\n" +
+	"\aarticle\x18\x05 \x01(\tR\aarticle\x12\x1c\n" +
+	"\twikipedia\x18\x06 \x01(\tR\twikipedia\x12\x1a\n" +
+	"\bpresskit\x18\a \x01(\tR\bpresskit\"W\n" +
+	"\rLaunchFailure\x12\x12\n" +
+	"\x04time\x18\x01 \x01(\x05R\x04time\x12\x1a\n" +
+	"\baltitude\x18\x02 \x01(\x05R\baltitude\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xb2\x02\n" +
+	"\n" +
+	"LaunchCore\x12\x17\n" +
+	"\acore_id\x18\x01 \x01(\tR\x06coreId\x12\x16\n" +
+	"\x06flight\x18\x02 \x01(\x05R\x06flight\x12\x1a\n" +
+	"\bgridfins\x18\x03 \x01(\bR\bgridfins\x12\x12\n" +
+	"\x04legs\x18\x04 \x01(\bR\x04legs\x12\x16\n" +
+	"\x06reused\x18\x05 \x01(\bR\x06reused\x12'\n" +
+	"\x0flanding_attempt\x18\x06 \x01(\bR\x0elandingAttempt\x12,\n" +
+	"\x0flanding_success\x18\a \x01(\bH\x00R\x0elandingSuccess\x88\x01\x01\x12!\n" +
+	"\flanding_type\x18\b \x01(\tR\vlandingType\x12\x1d\n" +
+	"\n" +
+	"landpad_id\x18\t \x01(\tR\tlandpadIdB\x12\n" +
+	"\x10_landing_success\"\xdc\x02\n" +
+	"\tLaunchpad\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x1a\n" +
+	"\blocality\x18\x04 \x01(\tR\blocality\x12\x16\n" +
+	"\x06region\x18\x05 \x01(\tR\x06region\x12\x1a\n" +
+	"\blatitude\x18\x06 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\a \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12'\n" +
+	"\x0flaunch_attempts\x18\t \x01(\x05R\x0elaunchAttempts\x12)\n" +
+	"\x10launch_successes\x18\n" +
+	" \x01(\x05R\x0flaunchSuccesses\x12$\n" +
+	"\vdistance_km\x18\v \x01(\x01H\x00R\n" +
+	"distanceKm\x88\x01\x01B\x0e\n" +
+	"\f_distance_km\"\xbc\x02\n" +
+	"\aLandpad\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tfull_name\x18\x03 \x01(\tR\bfullName\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\blocality\x18\x05 \x01(\tR\blocality\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12\x1a\n" +
+	"\blatitude\x18\a \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\b \x01(\x01R\tlongitude\x12)\n" +
+	"\x10landing_attempts\x18\t \x01(\x05R\x0flandingAttempts\x12+\n" +
+	"\x11landing_successes\x18\n" +
+	" \x01(\x05R\x10landingSuccesses\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\"\xa6\x01\n" +
+	"\aPayload\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06reused\x18\x04 \x01(\bR\x06reused\x12\x1c\n" +
+	"\tcustomers\x18\x05 \x03(\tR\tcustomers\x12\x14\n" +
+	"\x05orbit\x18\x06 \x01(\tR\x05orbit\x12\x17\n" +
+	"\amass_kg\x18\a \x01(\x01R\x06massKg\"\xb3\x01\n" +
+	"\n" +
+	"CrewMember\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06agency\x18\x03 \x01(\tR\x06agency\x12\x14\n" +
+	"\x05image\x18\x04 \x01(\tR\x05image\x12\x1c\n" +
+	"\twikipedia\x18\x05 \x01(\tR\twikipedia\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"launch_ids\x18\a \x03(\tR\tlaunchIds\"\x8a\x02\n" +
+	"\aCapsule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06serial\x18\x02 \x01(\tR\x06serial\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1f\n" +
+	"\vreuse_count\x18\x05 \x01(\x05R\n" +
+	"reuseCount\x12%\n" +
+	"\x0ewater_landings\x18\x06 \x01(\x05R\rwaterLandings\x12#\n" +
+	"\rland_landings\x18\a \x01(\x05R\flandLandings\x12\x1f\n" +
+	"\vlast_update\x18\b \x01(\tR\n" +
+	"lastUpdate\x12\x1d\n" +
+	"\n" +
+	"launch_ids\x18\t \x03(\tR\tlaunchIds\"\xd1\x02\n" +
+	"\x04Core\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06serial\x18\x02 \x01(\tR\x06serial\x12\x14\n" +
+	"\x05block\x18\x03 \x01(\x05R\x05block\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
+	"\vreuse_count\x18\x05 \x01(\x05R\n" +
+	"reuseCount\x12#\n" +
+	"\rrtls_attempts\x18\x06 \x01(\x05R\frtlsAttempts\x12#\n" +
+	"\rrtls_landings\x18\a \x01(\x05R\frtlsLandings\x12#\n" +
+	"\rasds_attempts\x18\b \x01(\x05R\fasdsAttempts\x12#\n" +
+	"\rasds_landings\x18\t \x01(\x05R\fasdsLandings\x12\x1f\n" +
+	"\vlast_update\x18\n" +
+	" \x01(\tR\n" +
+	"lastUpdate\x12\x1d\n" +
+	"\n" +
+	"launch_ids\x18\v \x03(\tR\tlaunchIds\"\x8c\x01\n" +
 	"\x06Rocket\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -529,13 +3189,35 @@ const file_lib_grpc_space_proto_rawDesc = "" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
 	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x14\n" +
 	"\x05found\x18\x03 \x01(\bR\x05found\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type2\x88\x02\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\"\xac\x01\n" +
+	"\x04APOD\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12 \n" +
+	"\vexplanation\x18\x03 \x01(\tR\vexplanation\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x05 \x01(\tR\tmediaType\x12'\n" +
+	"\x0fservice_version\x18\x06 \x01(\tR\x0eserviceVersion2\xf9\b\n" +
 	"\rLaunchService\x12>\n" +
-	"\x0fGetLatestLaunch\x12\x1a.space.LatestLaunchRequest\x1a\r.space.Launch\"\x00\x125\n" +
+	"\x0fGetLatestLaunch\x12\x1a.space.LatestLaunchRequest\x1a\r.space.Launch\"\x00\x12F\n" +
+	"\rGetNextLaunch\x12\x1b.space.GetNextLaunchRequest\x1a\x16.space.LaunchCountdown\"\x00\x12;\n" +
+	"\tGetLaunch\x12\x17.space.GetLaunchRequest\x1a\x13.space.LaunchDetail\"\x00\x12I\n" +
+	"\fListLaunches\x12\x1a.space.ListLaunchesRequest\x1a\x1b.space.ListLaunchesResponse\"\x00\x125\n" +
 	"\tGetRocket\x12\x17.space.GetRocketRequest\x1a\r.space.Rocket\"\x00\x12C\n" +
 	"\n" +
-	"GetRockets\x12\x18.space.GetRocketsRequest\x1a\x19.space.GetRocketsResponse\"\x00\x12;\n" +
-	"\vGetMathFact\x12\x19.space.GetMathFactRequest\x1a\x0f.space.MathFact\"\x00B\x18Z\x16outerspace-go/lib/grpcb\x06proto3"
+	"GetRockets\x12\x18.space.GetRocketsRequest\x1a\x19.space.GetRocketsResponse\"\x00\x12R\n" +
+	"\x0fBatchGetRockets\x12\x1d.space.BatchGetRocketsRequest\x1a\x1e.space.BatchGetRocketsResponse\"\x00\x12F\n" +
+	"\vGetCapsules\x12\x19.space.GetCapsulesRequest\x1a\x1a.space.GetCapsulesResponse\"\x00\x128\n" +
+	"\n" +
+	"GetCapsule\x12\x18.space.GetCapsuleRequest\x1a\x0e.space.Capsule\"\x00\x12=\n" +
+	"\bGetCores\x12\x16.space.GetCoresRequest\x1a\x17.space.GetCoresResponse\"\x00\x12:\n" +
+	"\aGetCrew\x12\x15.space.GetCrewRequest\x1a\x16.space.GetCrewResponse\"\x00\x12L\n" +
+	"\rGetLaunchpads\x12\x1b.space.GetLaunchpadsRequest\x1a\x1c.space.GetLaunchpadsResponse\"\x00\x12>\n" +
+	"\fGetLaunchpad\x12\x1a.space.GetLaunchpadRequest\x1a\x10.space.Launchpad\"\x00\x12F\n" +
+	"\vGetLandpads\x12\x19.space.GetLandpadsRequest\x1a\x1a.space.GetLandpadsResponse\"\x00\x12;\n" +
+	"\vGetMathFact\x12\x19.space.GetMathFactRequest\x1a\x0f.space.MathFact\"\x00\x12/\n" +
+	"\aGetAPOD\x12\x15.space.GetAPODRequest\x1a\v.space.APOD\"\x00\x12G\n" +
+	"\x11WatchLatestLaunch\x12\x1f.space.WatchLatestLaunchRequest\x1a\r.space.Launch\"\x000\x01B\x18Z\x16outerspace-go/lib/grpcb\x06proto3"
 
 var (
 	file_lib_grpc_space_proto_rawDescOnce sync.Once
@@ -549,33 +3231,112 @@ func file_lib_grpc_space_proto_rawDescGZIP() []byte {
 	return file_lib_grpc_space_proto_rawDescData
 }
 
-var file_lib_grpc_space_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_lib_grpc_space_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_lib_grpc_space_proto_goTypes = []any{
-	(*LatestLaunchRequest)(nil), // 0: space.LatestLaunchRequest
-	(*GetRocketRequest)(nil),    // 1: space.GetRocketRequest
-	(*GetRocketsRequest)(nil),   // 2: space.GetRocketsRequest
-	(*GetRocketsResponse)(nil),  // 3: space.GetRocketsResponse
-	(*GetMathFactRequest)(nil),  // 4: space.GetMathFactRequest
-	(*Launch)(nil),              // 5: space.Launch
-	(*Rocket)(nil),              // 6: space.Rocket
-	(*RocketSummary)(nil),       // 7: space.RocketSummary
-	(*MathFact)(nil),            // 8: space.MathFact
+	(*LatestLaunchRequest)(nil),      // 0: space.LatestLaunchRequest
+	(*WatchLatestLaunchRequest)(nil), // 1: space.WatchLatestLaunchRequest
+	(*GetNextLaunchRequest)(nil),     // 2: space.GetNextLaunchRequest
+	(*LaunchCountdown)(nil),          // 3: space.LaunchCountdown
+	(*GetLaunchRequest)(nil),         // 4: space.GetLaunchRequest
+	(*ListLaunchesRequest)(nil),      // 5: space.ListLaunchesRequest
+	(*ListLaunchesResponse)(nil),     // 6: space.ListLaunchesResponse
+	(*GetRocketRequest)(nil),         // 7: space.GetRocketRequest
+	(*GetRocketsRequest)(nil),        // 8: space.GetRocketsRequest
+	(*GetRocketsResponse)(nil),       // 9: space.GetRocketsResponse
+	(*BatchGetRocketsRequest)(nil),   // 10: space.BatchGetRocketsRequest
+	(*BatchGetRocketsResponse)(nil),  // 11: space.BatchGetRocketsResponse
+	(*RocketResult)(nil),             // 12: space.RocketResult
+	(*RocketError)(nil),              // 13: space.RocketError
+	(*GetCapsulesRequest)(nil),       // 14: space.GetCapsulesRequest
+	(*GetCapsulesResponse)(nil),      // 15: space.GetCapsulesResponse
+	(*GetCapsuleRequest)(nil),        // 16: space.GetCapsuleRequest
+	(*GetCoresRequest)(nil),          // 17: space.GetCoresRequest
+	(*GetCoresResponse)(nil),         // 18: space.GetCoresResponse
+	(*GetCrewRequest)(nil),           // 19: space.GetCrewRequest
+	(*GetCrewResponse)(nil),          // 20: space.GetCrewResponse
+	(*LatLng)(nil),                   // 21: space.LatLng
+	(*GetLaunchpadsRequest)(nil),     // 22: space.GetLaunchpadsRequest
+	(*GetLaunchpadsResponse)(nil),    // 23: space.GetLaunchpadsResponse
+	(*GetLaunchpadRequest)(nil),      // 24: space.GetLaunchpadRequest
+	(*GetLandpadsRequest)(nil),       // 25: space.GetLandpadsRequest
+	(*GetLandpadsResponse)(nil),      // 26: space.GetLandpadsResponse
+	(*GetMathFactRequest)(nil),       // 27: space.GetMathFactRequest
+	(*GetAPODRequest)(nil),           // 28: space.GetAPODRequest
+	(*Launch)(nil),                   // 29: space.Launch
+	(*LaunchDetail)(nil),             // 30: space.LaunchDetail
+	(*LaunchLinks)(nil),              // 31: space.LaunchLinks
+	(*LaunchFailure)(nil),            // 32: space.LaunchFailure
+	(*LaunchCore)(nil),               // 33: space.LaunchCore
+	(*Launchpad)(nil),                // 34: space.Launchpad
+	(*Landpad)(nil),                  // 35: space.Landpad
+	(*Payload)(nil),                  // 36: space.Payload
+	(*CrewMember)(nil),               // 37: space.CrewMember
+	(*Capsule)(nil),                  // 38: space.Capsule
+	(*Core)(nil),                     // 39: space.Core
+	(*Rocket)(nil),                   // 40: space.Rocket
+	(*RocketSummary)(nil),            // 41: space.RocketSummary
+	(*MathFact)(nil),                 // 42: space.MathFact
+	(*APOD)(nil),                     // 43: space.APOD
 }
 var file_lib_grpc_space_proto_depIdxs = []int32{
-	7, // 0: space.GetRocketsResponse.rockets:type_name -> space.RocketSummary
-	0, // 1: space.LaunchService.GetLatestLaunch:input_type -> space.LatestLaunchRequest
-	1, // 2: space.LaunchService.GetRocket:input_type -> space.GetRocketRequest
-	2, // 3: space.LaunchService.GetRockets:input_type -> space.GetRocketsRequest
-	4, // 4: space.LaunchService.GetMathFact:input_type -> space.GetMathFactRequest
-	5, // 5: space.LaunchService.GetLatestLaunch:output_type -> space.Launch
-	6, // 6: space.LaunchService.GetRocket:output_type -> space.Rocket
-	3, // 7: space.LaunchService.GetRockets:output_type -> space.GetRocketsResponse
-	8, // 8: space.LaunchService.GetMathFact:output_type -> space.MathFact
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	29, // 0: space.LaunchCountdown.launch:type_name -> space.Launch
+	29, // 1: space.ListLaunchesResponse.launches:type_name -> space.Launch
+	41, // 2: space.GetRocketsResponse.rockets:type_name -> space.RocketSummary
+	12, // 3: space.BatchGetRocketsResponse.results:type_name -> space.RocketResult
+	40, // 4: space.RocketResult.rocket:type_name -> space.Rocket
+	13, // 5: space.RocketResult.error:type_name -> space.RocketError
+	38, // 6: space.GetCapsulesResponse.capsules:type_name -> space.Capsule
+	39, // 7: space.GetCoresResponse.cores:type_name -> space.Core
+	37, // 8: space.GetCrewResponse.crew:type_name -> space.CrewMember
+	21, // 9: space.GetLaunchpadsRequest.near:type_name -> space.LatLng
+	34, // 10: space.GetLaunchpadsResponse.launchpads:type_name -> space.Launchpad
+	35, // 11: space.GetLandpadsResponse.landpads:type_name -> space.Landpad
+	31, // 12: space.LaunchDetail.links:type_name -> space.LaunchLinks
+	32, // 13: space.LaunchDetail.failures:type_name -> space.LaunchFailure
+	33, // 14: space.LaunchDetail.cores:type_name -> space.LaunchCore
+	40, // 15: space.LaunchDetail.rocket:type_name -> space.Rocket
+	34, // 16: space.LaunchDetail.launchpad:type_name -> space.Launchpad
+	36, // 17: space.LaunchDetail.payloads:type_name -> space.Payload
+	37, // 18: space.LaunchDetail.crew:type_name -> space.CrewMember
+	0,  // 19: space.LaunchService.GetLatestLaunch:input_type -> space.LatestLaunchRequest
+	2,  // 20: space.LaunchService.GetNextLaunch:input_type -> space.GetNextLaunchRequest
+	4,  // 21: space.LaunchService.GetLaunch:input_type -> space.GetLaunchRequest
+	5,  // 22: space.LaunchService.ListLaunches:input_type -> space.ListLaunchesRequest
+	7,  // 23: space.LaunchService.GetRocket:input_type -> space.GetRocketRequest
+	8,  // 24: space.LaunchService.GetRockets:input_type -> space.GetRocketsRequest
+	10, // 25: space.LaunchService.BatchGetRockets:input_type -> space.BatchGetRocketsRequest
+	14, // 26: space.LaunchService.GetCapsules:input_type -> space.GetCapsulesRequest
+	16, // 27: space.LaunchService.GetCapsule:input_type -> space.GetCapsuleRequest
+	17, // 28: space.LaunchService.GetCores:input_type -> space.GetCoresRequest
+	19, // 29: space.LaunchService.GetCrew:input_type -> space.GetCrewRequest
+	22, // 30: space.LaunchService.GetLaunchpads:input_type -> space.GetLaunchpadsRequest
+	24, // 31: space.LaunchService.GetLaunchpad:input_type -> space.GetLaunchpadRequest
+	25, // 32: space.LaunchService.GetLandpads:input_type -> space.GetLandpadsRequest
+	27, // 33: space.LaunchService.GetMathFact:input_type -> space.GetMathFactRequest
+	28, // 34: space.LaunchService.GetAPOD:input_type -> space.GetAPODRequest
+	1,  // 35: space.LaunchService.WatchLatestLaunch:input_type -> space.WatchLatestLaunchRequest
+	29, // 36: space.LaunchService.GetLatestLaunch:output_type -> space.Launch
+	3,  // 37: space.LaunchService.GetNextLaunch:output_type -> space.LaunchCountdown
+	30, // 38: space.LaunchService.GetLaunch:output_type -> space.LaunchDetail
+	6,  // 39: space.LaunchService.ListLaunches:output_type -> space.ListLaunchesResponse
+	40, // 40: space.LaunchService.GetRocket:output_type -> space.Rocket
+	9,  // 41: space.LaunchService.GetRockets:output_type -> space.GetRocketsResponse
+	11, // 42: space.LaunchService.BatchGetRockets:output_type -> space.BatchGetRocketsResponse
+	15, // 43: space.LaunchService.GetCapsules:output_type -> space.GetCapsulesResponse
+	38, // 44: space.LaunchService.GetCapsule:output_type -> space.Capsule
+	18, // 45: space.LaunchService.GetCores:output_type -> space.GetCoresResponse
+	20, // 46: space.LaunchService.GetCrew:output_type -> space.GetCrewResponse
+	23, // 47: space.LaunchService.GetLaunchpads:output_type -> space.GetLaunchpadsResponse
+	34, // 48: space.LaunchService.GetLaunchpad:output_type -> space.Launchpad
+	26, // 49: space.LaunchService.GetLandpads:output_type -> space.GetLandpadsResponse
+	42, // 50: space.LaunchService.GetMathFact:output_type -> space.MathFact
+	43, // 51: space.LaunchService.GetAPOD:output_type -> space.APOD
+	29, // 52: space.LaunchService.WatchLatestLaunch:output_type -> space.Launch
+	36, // [36:53] is the sub-list for method output_type
+	19, // [19:36] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_lib_grpc_space_proto_init() }
@@ -583,13 +3344,18 @@ func file_lib_grpc_space_proto_init() {
 	if File_lib_grpc_space_proto != nil {
 		return
 	}
+	file_lib_grpc_space_proto_msgTypes[5].OneofWrappers = []any{}
+	file_lib_grpc_space_proto_msgTypes[29].OneofWrappers = []any{}
+	file_lib_grpc_space_proto_msgTypes[30].OneofWrappers = []any{}
+	file_lib_grpc_space_proto_msgTypes[33].OneofWrappers = []any{}
+	file_lib_grpc_space_proto_msgTypes[34].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lib_grpc_space_proto_rawDesc), len(file_lib_grpc_space_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
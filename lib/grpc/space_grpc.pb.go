@@ -19,10 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LaunchService_GetLatestLaunch_FullMethodName = "/space.LaunchService/GetLatestLaunch"
-	LaunchService_GetRocket_FullMethodName       = "/space.LaunchService/GetRocket"
-	LaunchService_GetRockets_FullMethodName      = "/space.LaunchService/GetRockets"
-	LaunchService_GetMathFact_FullMethodName     = "/space.LaunchService/GetMathFact"
+	LaunchService_GetLatestLaunch_FullMethodName   = "/space.LaunchService/GetLatestLaunch"
+	LaunchService_GetNextLaunch_FullMethodName     = "/space.LaunchService/GetNextLaunch"
+	LaunchService_GetLaunch_FullMethodName         = "/space.LaunchService/GetLaunch"
+	LaunchService_ListLaunches_FullMethodName      = "/space.LaunchService/ListLaunches"
+	LaunchService_GetRocket_FullMethodName         = "/space.LaunchService/GetRocket"
+	LaunchService_GetRockets_FullMethodName        = "/space.LaunchService/GetRockets"
+	LaunchService_BatchGetRockets_FullMethodName   = "/space.LaunchService/BatchGetRockets"
+	LaunchService_GetCapsules_FullMethodName       = "/space.LaunchService/GetCapsules"
+	LaunchService_GetCapsule_FullMethodName        = "/space.LaunchService/GetCapsule"
+	LaunchService_GetCores_FullMethodName          = "/space.LaunchService/GetCores"
+	LaunchService_GetCrew_FullMethodName           = "/space.LaunchService/GetCrew"
+	LaunchService_GetLaunchpads_FullMethodName     = "/space.LaunchService/GetLaunchpads"
+	LaunchService_GetLaunchpad_FullMethodName      = "/space.LaunchService/GetLaunchpad"
+	LaunchService_GetLandpads_FullMethodName       = "/space.LaunchService/GetLandpads"
+	LaunchService_GetMathFact_FullMethodName       = "/space.LaunchService/GetMathFact"
+	LaunchService_GetAPOD_FullMethodName           = "/space.LaunchService/GetAPOD"
+	LaunchService_WatchLatestLaunch_FullMethodName = "/space.LaunchService/WatchLatestLaunch"
 )
 
 // LaunchServiceClient is the client API for LaunchService service.
@@ -33,12 +46,41 @@ const (
 type LaunchServiceClient interface {
 	// Get the latest launch
 	GetLatestLaunch(ctx context.Context, in *LatestLaunchRequest, opts ...grpc.CallOption) (*Launch, error)
+	// Get the next upcoming launch with the time left until it
+	GetNextLaunch(ctx context.Context, in *GetNextLaunchRequest, opts ...grpc.CallOption) (*LaunchCountdown, error)
+	// Get all details of a launch by ID, optionally with the documents it
+	// references
+	GetLaunch(ctx context.Context, in *GetLaunchRequest, opts ...grpc.CallOption) (*LaunchDetail, error)
+	// List launches a page at a time, filtered by date, outcome and rocket
+	ListLaunches(ctx context.Context, in *ListLaunchesRequest, opts ...grpc.CallOption) (*ListLaunchesResponse, error)
 	// Get a specific rocket by ID
 	GetRocket(ctx context.Context, in *GetRocketRequest, opts ...grpc.CallOption) (*Rocket, error)
 	// Get all rockets
 	GetRockets(ctx context.Context, in *GetRocketsRequest, opts ...grpc.CallOption) (*GetRocketsResponse, error)
+	// Get several rockets by ID at once. Every ID gets its own result, in the
+	// order requested, so one failing ID does not fail the others
+	BatchGetRockets(ctx context.Context, in *BatchGetRocketsRequest, opts ...grpc.CallOption) (*BatchGetRocketsResponse, error)
+	// Get all Dragon capsules
+	GetCapsules(ctx context.Context, in *GetCapsulesRequest, opts ...grpc.CallOption) (*GetCapsulesResponse, error)
+	// Get a specific capsule by ID
+	GetCapsule(ctx context.Context, in *GetCapsuleRequest, opts ...grpc.CallOption) (*Capsule, error)
+	// Get all first stage cores
+	GetCores(ctx context.Context, in *GetCoresRequest, opts ...grpc.CallOption) (*GetCoresResponse, error)
+	// Get all crew members
+	GetCrew(ctx context.Context, in *GetCrewRequest, opts ...grpc.CallOption) (*GetCrewResponse, error)
+	// Get all launchpads, or with near set those around a point, closest first
+	GetLaunchpads(ctx context.Context, in *GetLaunchpadsRequest, opts ...grpc.CallOption) (*GetLaunchpadsResponse, error)
+	// Get a specific launchpad by ID
+	GetLaunchpad(ctx context.Context, in *GetLaunchpadRequest, opts ...grpc.CallOption) (*Launchpad, error)
+	// Get all landing zones and drone ships
+	GetLandpads(ctx context.Context, in *GetLandpadsRequest, opts ...grpc.CallOption) (*GetLandpadsResponse, error)
 	// Get a random math fact
 	GetMathFact(ctx context.Context, in *GetMathFactRequest, opts ...grpc.CallOption) (*MathFact, error)
+	// Get NASA's Astronomy Picture of the Day
+	GetAPOD(ctx context.Context, in *GetAPODRequest, opts ...grpc.CallOption) (*APOD, error)
+	// Stream the latest launch, sending it once and then again whenever its
+	// flight number or success changes
+	WatchLatestLaunch(ctx context.Context, in *WatchLatestLaunchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Launch], error)
 }
 
 type launchServiceClient struct {
@@ -59,6 +101,36 @@ func (c *launchServiceClient) GetLatestLaunch(ctx context.Context, in *LatestLau
 	return out, nil
 }
 
+func (c *launchServiceClient) GetNextLaunch(ctx context.Context, in *GetNextLaunchRequest, opts ...grpc.CallOption) (*LaunchCountdown, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LaunchCountdown)
+	err := c.cc.Invoke(ctx, LaunchService_GetNextLaunch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) GetLaunch(ctx context.Context, in *GetLaunchRequest, opts ...grpc.CallOption) (*LaunchDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LaunchDetail)
+	err := c.cc.Invoke(ctx, LaunchService_GetLaunch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) ListLaunches(ctx context.Context, in *ListLaunchesRequest, opts ...grpc.CallOption) (*ListLaunchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLaunchesResponse)
+	err := c.cc.Invoke(ctx, LaunchService_ListLaunches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *launchServiceClient) GetRocket(ctx context.Context, in *GetRocketRequest, opts ...grpc.CallOption) (*Rocket, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Rocket)
@@ -79,6 +151,86 @@ func (c *launchServiceClient) GetRockets(ctx context.Context, in *GetRocketsRequ
 	return out, nil
 }
 
+func (c *launchServiceClient) BatchGetRockets(ctx context.Context, in *BatchGetRocketsRequest, opts ...grpc.CallOption) (*BatchGetRocketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetRocketsResponse)
+	err := c.cc.Invoke(ctx, LaunchService_BatchGetRockets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) GetCapsules(ctx context.Context, in *GetCapsulesRequest, opts ...grpc.CallOption) (*GetCapsulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapsulesResponse)
+	err := c.cc.Invoke(ctx, LaunchService_GetCapsules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) GetCapsule(ctx context.Context, in *GetCapsuleRequest, opts ...grpc.CallOption) (*Capsule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Capsule)
+	err := c.cc.Invoke(ctx, LaunchService_GetCapsule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) GetCores(ctx context.Context, in *GetCoresRequest, opts ...grpc.CallOption) (*GetCoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoresResponse)
+	err := c.cc.Invoke(ctx, LaunchService_GetCores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) GetCrew(ctx context.Context, in *GetCrewRequest, opts ...grpc.CallOption) (*GetCrewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCrewResponse)
+	err := c.cc.Invoke(ctx, LaunchService_GetCrew_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) GetLaunchpads(ctx context.Context, in *GetLaunchpadsRequest, opts ...grpc.CallOption) (*GetLaunchpadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLaunchpadsResponse)
+	err := c.cc.Invoke(ctx, LaunchService_GetLaunchpads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) GetLaunchpad(ctx context.Context, in *GetLaunchpadRequest, opts ...grpc.CallOption) (*Launchpad, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Launchpad)
+	err := c.cc.Invoke(ctx, LaunchService_GetLaunchpad_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) GetLandpads(ctx context.Context, in *GetLandpadsRequest, opts ...grpc.CallOption) (*GetLandpadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLandpadsResponse)
+	err := c.cc.Invoke(ctx, LaunchService_GetLandpads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *launchServiceClient) GetMathFact(ctx context.Context, in *GetMathFactRequest, opts ...grpc.CallOption) (*MathFact, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MathFact)
@@ -89,6 +241,35 @@ func (c *launchServiceClient) GetMathFact(ctx context.Context, in *GetMathFactRe
 	return out, nil
 }
 
+func (c *launchServiceClient) GetAPOD(ctx context.Context, in *GetAPODRequest, opts ...grpc.CallOption) (*APOD, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APOD)
+	err := c.cc.Invoke(ctx, LaunchService_GetAPOD_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *launchServiceClient) WatchLatestLaunch(ctx context.Context, in *WatchLatestLaunchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Launch], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LaunchService_ServiceDesc.Streams[0], LaunchService_WatchLatestLaunch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchLatestLaunchRequest, Launch]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LaunchService_WatchLatestLaunchClient = grpc.ServerStreamingClient[Launch]
+
 // LaunchServiceServer is the server API for LaunchService service.
 // All implementations must embed UnimplementedLaunchServiceServer
 // for forward compatibility.
@@ -97,12 +278,41 @@ func (c *launchServiceClient) GetMathFact(ctx context.Context, in *GetMathFactRe
 type LaunchServiceServer interface {
 	// Get the latest launch
 	GetLatestLaunch(context.Context, *LatestLaunchRequest) (*Launch, error)
+	// Get the next upcoming launch with the time left until it
+	GetNextLaunch(context.Context, *GetNextLaunchRequest) (*LaunchCountdown, error)
+	// Get all details of a launch by ID, optionally with the documents it
+	// references
+	GetLaunch(context.Context, *GetLaunchRequest) (*LaunchDetail, error)
+	// List launches a page at a time, filtered by date, outcome and rocket
+	ListLaunches(context.Context, *ListLaunchesRequest) (*ListLaunchesResponse, error)
 	// Get a specific rocket by ID
 	GetRocket(context.Context, *GetRocketRequest) (*Rocket, error)
 	// Get all rockets
 	GetRockets(context.Context, *GetRocketsRequest) (*GetRocketsResponse, error)
+	// Get several rockets by ID at once. Every ID gets its own result, in the
+	// order requested, so one failing ID does not fail the others
+	BatchGetRockets(context.Context, *BatchGetRocketsRequest) (*BatchGetRocketsResponse, error)
+	// Get all Dragon capsules
+	GetCapsules(context.Context, *GetCapsulesRequest) (*GetCapsulesResponse, error)
+	// Get a specific capsule by ID
+	GetCapsule(context.Context, *GetCapsuleRequest) (*Capsule, error)
+	// Get all first stage cores
+	GetCores(context.Context, *GetCoresRequest) (*GetCoresResponse, error)
+	// Get all crew members
+	GetCrew(context.Context, *GetCrewRequest) (*GetCrewResponse, error)
+	// Get all launchpads, or with near set those around a point, closest first
+	GetLaunchpads(context.Context, *GetLaunchpadsRequest) (*GetLaunchpadsResponse, error)
+	// Get a specific launchpad by ID
+	GetLaunchpad(context.Context, *GetLaunchpadRequest) (*Launchpad, error)
+	// Get all landing zones and drone ships
+	GetLandpads(context.Context, *GetLandpadsRequest) (*GetLandpadsResponse, error)
 	// Get a random math fact
 	GetMathFact(context.Context, *GetMathFactRequest) (*MathFact, error)
+	// Get NASA's Astronomy Picture of the Day
+	GetAPOD(context.Context, *GetAPODRequest) (*APOD, error)
+	// Stream the latest launch, sending it once and then again whenever its
+	// flight number or success changes
+	WatchLatestLaunch(*WatchLatestLaunchRequest, grpc.ServerStreamingServer[Launch]) error
 	mustEmbedUnimplementedLaunchServiceServer()
 }
 
@@ -116,15 +326,54 @@ type UnimplementedLaunchServiceServer struct{}
 func (UnimplementedLaunchServiceServer) GetLatestLaunch(context.Context, *LatestLaunchRequest) (*Launch, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLatestLaunch not implemented")
 }
+func (UnimplementedLaunchServiceServer) GetNextLaunch(context.Context, *GetNextLaunchRequest) (*LaunchCountdown, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextLaunch not implemented")
+}
+func (UnimplementedLaunchServiceServer) GetLaunch(context.Context, *GetLaunchRequest) (*LaunchDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLaunch not implemented")
+}
+func (UnimplementedLaunchServiceServer) ListLaunches(context.Context, *ListLaunchesRequest) (*ListLaunchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLaunches not implemented")
+}
 func (UnimplementedLaunchServiceServer) GetRocket(context.Context, *GetRocketRequest) (*Rocket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRocket not implemented")
 }
 func (UnimplementedLaunchServiceServer) GetRockets(context.Context, *GetRocketsRequest) (*GetRocketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRockets not implemented")
 }
+func (UnimplementedLaunchServiceServer) BatchGetRockets(context.Context, *BatchGetRocketsRequest) (*BatchGetRocketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetRockets not implemented")
+}
+func (UnimplementedLaunchServiceServer) GetCapsules(context.Context, *GetCapsulesRequest) (*GetCapsulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapsules not implemented")
+}
+func (UnimplementedLaunchServiceServer) GetCapsule(context.Context, *GetCapsuleRequest) (*Capsule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapsule not implemented")
+}
+func (UnimplementedLaunchServiceServer) GetCores(context.Context, *GetCoresRequest) (*GetCoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCores not implemented")
+}
+func (UnimplementedLaunchServiceServer) GetCrew(context.Context, *GetCrewRequest) (*GetCrewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrew not implemented")
+}
+func (UnimplementedLaunchServiceServer) GetLaunchpads(context.Context, *GetLaunchpadsRequest) (*GetLaunchpadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLaunchpads not implemented")
+}
+func (UnimplementedLaunchServiceServer) GetLaunchpad(context.Context, *GetLaunchpadRequest) (*Launchpad, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLaunchpad not implemented")
+}
+func (UnimplementedLaunchServiceServer) GetLandpads(context.Context, *GetLandpadsRequest) (*GetLandpadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLandpads not implemented")
+}
 func (UnimplementedLaunchServiceServer) GetMathFact(context.Context, *GetMathFactRequest) (*MathFact, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMathFact not implemented")
 }
+func (UnimplementedLaunchServiceServer) GetAPOD(context.Context, *GetAPODRequest) (*APOD, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAPOD not implemented")
+}
+func (UnimplementedLaunchServiceServer) WatchLatestLaunch(*WatchLatestLaunchRequest, grpc.ServerStreamingServer[Launch]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchLatestLaunch not implemented")
+}
 func (UnimplementedLaunchServiceServer) mustEmbedUnimplementedLaunchServiceServer() {}
 func (UnimplementedLaunchServiceServer) testEmbeddedByValue()                       {}
 
@@ -164,6 +413,60 @@ func _LaunchService_GetLatestLaunch_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LaunchService_GetNextLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextLaunchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetNextLaunch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetNextLaunch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetNextLaunch(ctx, req.(*GetNextLaunchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_GetLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLaunchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetLaunch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetLaunch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetLaunch(ctx, req.(*GetLaunchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_ListLaunches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLaunchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).ListLaunches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_ListLaunches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).ListLaunches(ctx, req.(*ListLaunchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LaunchService_GetRocket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRocketRequest)
 	if err := dec(in); err != nil {
@@ -200,6 +503,150 @@ func _LaunchService_GetRockets_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LaunchService_BatchGetRockets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRocketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).BatchGetRockets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_BatchGetRockets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).BatchGetRockets(ctx, req.(*BatchGetRocketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_GetCapsules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapsulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetCapsules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetCapsules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetCapsules(ctx, req.(*GetCapsulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_GetCapsule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapsuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetCapsule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetCapsule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetCapsule(ctx, req.(*GetCapsuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_GetCores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetCores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetCores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetCores(ctx, req.(*GetCoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_GetCrew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetCrew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetCrew_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetCrew(ctx, req.(*GetCrewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_GetLaunchpads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLaunchpadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetLaunchpads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetLaunchpads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetLaunchpads(ctx, req.(*GetLaunchpadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_GetLaunchpad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLaunchpadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetLaunchpad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetLaunchpad_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetLaunchpad(ctx, req.(*GetLaunchpadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_GetLandpads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLandpadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetLandpads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetLandpads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetLandpads(ctx, req.(*GetLandpadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LaunchService_GetMathFact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMathFactRequest)
 	if err := dec(in); err != nil {
@@ -218,6 +665,35 @@ func _LaunchService_GetMathFact_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LaunchService_GetAPOD_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPODRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LaunchServiceServer).GetAPOD(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LaunchService_GetAPOD_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LaunchServiceServer).GetAPOD(ctx, req.(*GetAPODRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LaunchService_WatchLatestLaunch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchLatestLaunchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LaunchServiceServer).WatchLatestLaunch(m, &grpc.GenericServerStream[WatchLatestLaunchRequest, Launch]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LaunchService_WatchLatestLaunchServer = grpc.ServerStreamingServer[Launch]
+
 // LaunchService_ServiceDesc is the grpc.ServiceDesc for LaunchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +705,18 @@ var LaunchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLatestLaunch",
 			Handler:    _LaunchService_GetLatestLaunch_Handler,
 		},
+		{
+			MethodName: "GetNextLaunch",
+			Handler:    _LaunchService_GetNextLaunch_Handler,
+		},
+		{
+			MethodName: "GetLaunch",
+			Handler:    _LaunchService_GetLaunch_Handler,
+		},
+		{
+			MethodName: "ListLaunches",
+			Handler:    _LaunchService_ListLaunches_Handler,
+		},
 		{
 			MethodName: "GetRocket",
 			Handler:    _LaunchService_GetRocket_Handler,
@@ -237,11 +725,53 @@ var LaunchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRockets",
 			Handler:    _LaunchService_GetRockets_Handler,
 		},
+		{
+			MethodName: "BatchGetRockets",
+			Handler:    _LaunchService_BatchGetRockets_Handler,
+		},
+		{
+			MethodName: "GetCapsules",
+			Handler:    _LaunchService_GetCapsules_Handler,
+		},
+		{
+			MethodName: "GetCapsule",
+			Handler:    _LaunchService_GetCapsule_Handler,
+		},
+		{
+			MethodName: "GetCores",
+			Handler:    _LaunchService_GetCores_Handler,
+		},
+		{
+			MethodName: "GetCrew",
+			Handler:    _LaunchService_GetCrew_Handler,
+		},
+		{
+			MethodName: "GetLaunchpads",
+			Handler:    _LaunchService_GetLaunchpads_Handler,
+		},
+		{
+			MethodName: "GetLaunchpad",
+			Handler:    _LaunchService_GetLaunchpad_Handler,
+		},
+		{
+			MethodName: "GetLandpads",
+			Handler:    _LaunchService_GetLandpads_Handler,
+		},
 		{
 			MethodName: "GetMathFact",
 			Handler:    _LaunchService_GetMathFact_Handler,
 		},
+		{
+			MethodName: "GetAPOD",
+			Handler:    _LaunchService_GetAPOD_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchLatestLaunch",
+			Handler:       _LaunchService_WatchLatestLaunch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lib/grpc/space.proto",
 }
@@ -0,0 +1,88 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"outerspace-go/lib/upstream"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the google.rpc.ErrorInfo attached to upstream errors
+const ErrorDomain = "outerspace-go"
+
+// Reasons of the google.rpc.ErrorInfo attached to upstream errors
+const (
+	ReasonUpstreamNotFound    = "UPSTREAM_NOT_FOUND"
+	ReasonUpstreamRateLimited = "UPSTREAM_RATE_LIMITED"
+	ReasonUpstreamUnavailable = "UPSTREAM_UNAVAILABLE"
+	ReasonUpstreamBadPayload  = "UPSTREAM_BAD_PAYLOAD"
+	ReasonUpstreamTimeout     = "UPSTREAM_TIMEOUT"
+)
+
+// toStatus converts an error from an upstream client into a gRPC status
+// error. Upstream errors get a code matching the kind of failure and a
+// google.rpc.ErrorInfo detail naming the upstream and whether the call may
+// be retried; cancellations keep their context code; anything else is
+// reported as codes.Internal.
+func toStatus(err error) error {
+	var upstreamErr *upstream.Error
+	if !errors.As(err, &upstreamErr) {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return status.FromContextError(err).Err()
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	code, reason := codes.Unavailable, ReasonUpstreamUnavailable
+	switch upstreamErr.Kind {
+	case upstream.ErrNotFound:
+		code, reason = codes.NotFound, ReasonUpstreamNotFound
+	case upstream.ErrRateLimited:
+		code, reason = codes.ResourceExhausted, ReasonUpstreamRateLimited
+	case upstream.ErrTimeout:
+		code, reason = codes.DeadlineExceeded, ReasonUpstreamTimeout
+	case upstream.ErrBadPayload:
+		reason = ReasonUpstreamBadPayload
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: ErrorDomain,
+		Metadata: map[string]string{
+			"upstream":  upstreamErr.Upstream,
+			"retryable": strconv.FormatBool(upstreamErr.Retryable()),
+		},
+	}
+	if upstreamErr.StatusCode != 0 {
+		info.Metadata["http_status"] = strconv.Itoa(upstreamErr.StatusCode)
+	}
+
+	st, detailErr := status.New(code, upstreamErr.Error()).WithDetails(info)
+	if detailErr != nil {
+		return status.Error(code, upstreamErr.Error())
+	}
+	return st.Err()
+}
+
+// toRocketError describes a failed item of a batch call with the same code,
+// reason and metadata that toStatus would report for it
+func toRocketError(err error) *RocketError {
+	st := status.Convert(toStatus(err))
+	rocketErr := &RocketError{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			rocketErr.Reason = info.Reason
+			rocketErr.Upstream = info.Metadata["upstream"]
+			rocketErr.Retryable = info.Metadata["retryable"] == "true"
+		}
+	}
+	return rocketErr
+}
@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"outerspace-go/lib"
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// noRetries keeps error tests fast by sending each upstream request once
+var noRetries = lib.WithRetryPolicy(upstream.RetryPolicy{MaxAttempts: 1})
+
+// upstreamServer starts a fake upstream API answering every request with handler
+func upstreamServer(t *testing.T, handler http.HandlerFunc) string {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// errorInfo returns the google.rpc.ErrorInfo detail of err
+func errorInfo(t *testing.T, err error) *errdetails.ErrorInfo {
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	t.Fatalf("no ErrorInfo in %v", err)
+	return nil
+}
+
+func TestToStatus_UpstreamErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		code      codes.Code
+		reason    string
+		retryable string
+	}{
+		{"not found", http.StatusNotFound, codes.NotFound, ReasonUpstreamNotFound, "false"},
+		{"rate limited", http.StatusTooManyRequests, codes.ResourceExhausted, ReasonUpstreamRateLimited, "true"},
+		{"unavailable", http.StatusServiceUnavailable, codes.Unavailable, ReasonUpstreamUnavailable, "true"},
+		{"gateway timeout", http.StatusGatewayTimeout, codes.DeadlineExceeded, ReasonUpstreamTimeout, "true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := upstreamServer(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			})
+			client := dialServer(t, New(lib.NewSpaceXClient(lib.WithBaseURL(url), noRetries), lib.NewNumbersClient(), lib.NewNASAClient()))
+
+			_, err := client.GetRocket(context.Background(), &GetRocketRequest{Id: "unknown"})
+
+			assert.Equal(t, tt.code, status.Code(err))
+			info := errorInfo(t, err)
+			assert.Equal(t, tt.reason, info.Reason)
+			assert.Equal(t, ErrorDomain, info.Domain)
+			assert.Equal(t, lib.SpaceXUpstream, info.Metadata["upstream"])
+			assert.Equal(t, tt.retryable, info.Metadata["retryable"])
+		})
+	}
+}
+
+func TestToStatus_BadPayload(t *testing.T) {
+	url := upstreamServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`not json`))
+	})
+	client := dialServer(t, New(lib.NewSpaceXClient(), lib.NewNumbersClient(lib.WithBaseURL(url)), lib.NewNASAClient()))
+
+	_, err := client.GetMathFact(context.Background(), &GetMathFactRequest{})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	info := errorInfo(t, err)
+	assert.Equal(t, ReasonUpstreamBadPayload, info.Reason)
+	assert.Equal(t, lib.NumbersUpstream, info.Metadata["upstream"])
+	assert.Equal(t, "false", info.Metadata["retryable"])
+}
+
+func TestToStatus_UpstreamTimeout(t *testing.T) {
+	url := upstreamServer(t, func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	spaceClient := lib.NewSpaceXClient(lib.WithBaseURL(url), lib.WithTimeout(50*time.Millisecond), noRetries)
+	client := dialServer(t, New(spaceClient, lib.NewNumbersClient(), lib.NewNASAClient()))
+
+	_, err := client.GetLatestLaunch(context.Background(), &LatestLaunchRequest{})
+
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, ReasonUpstreamTimeout, errorInfo(t, err).Reason)
+}
+
+func TestToStatus_UnclassifiedError(t *testing.T) {
+	assert.Equal(t, codes.Internal, status.Code(toStatus(assert.AnError)))
+	assert.Equal(t, codes.Canceled, status.Code(toStatus(context.Canceled)))
+}
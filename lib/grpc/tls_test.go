@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"outerspace-go/lib"
+	"outerspace-go/lib/tlsconfig"
+	"outerspace-go/lib/tlsconfig/tlstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTLS_MutualAuthentication(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	caFile := ca.WriteCert(t, dir)
+	serverCert, serverKey := ca.WriteKeyPair(t, dir, "server")
+	clientCert, clientKey := ca.WriteKeyPair(t, dir, "client")
+
+	serverConfig, err := tlsconfig.Server(serverCert, serverKey, caFile)
+	require.NoError(t, err)
+	numbers := new(MockNumbersClient)
+	numbers.On("GetMathFact", mock.Anything).Return(&lib.MathFact{Text: "42 is the answer", Number: 42}, nil)
+	server := New(new(MockSpaceXClient), numbers, new(MockNASAClient), WithTLS(serverConfig))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	t.Run("client certificate", func(t *testing.T) {
+		clientConfig, err := tlsconfig.Client(caFile, clientCert, clientKey)
+		require.NoError(t, err)
+		client, err := NewClient(lis.Addr().String(), WithClientTLS(clientConfig))
+		require.NoError(t, err)
+		defer client.Close()
+
+		fact, err := client.GetMathFact(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(42), fact.Number)
+	})
+
+	t.Run("no client certificate", func(t *testing.T) {
+		clientConfig, err := tlsconfig.Client(caFile, "", "")
+		require.NoError(t, err)
+		client, err := NewClient(lis.Addr().String(), WithClientTLS(clientConfig))
+		require.NoError(t, err)
+		defer client.Close()
+
+		_, err = client.GetMathFact(context.Background())
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("plaintext", func(t *testing.T) {
+		client, err := NewClient(lis.Addr().String())
+		require.NoError(t, err)
+		defer client.Close()
+
+		_, err = client.GetMathFact(context.Background())
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
@@ -3,21 +3,34 @@ package lib
 import (
 	"encoding/json"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"outerspace-go/lib/requestid"
+	"outerspace-go/lib/upstream"
+
 	"github.com/rs/zerolog/log"
 )
 
-// LoggingMiddleware wraps an http.HandlerFunc and logs request details
+// LoggingMiddleware wraps an http.HandlerFunc and logs request details. Each
+// request is tagged with the caller's X-Request-Id, or a new one, which is
+// echoed in the response and carried in the request context.
 func LoggingMiddleware(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
 
+		id := requestid.FromCaller(r.Header.Get(requestid.Header))
+		w.Header().Set(requestid.Header, id)
+		r = r.WithContext(requestid.NewContext(r.Context(), id))
+
 		// Call the next handler
 		next(w, r)
 
 		// Log the request details after it's completed
 		log.Info().
+			Str("request_id", id).
 			Str("method", r.Method).
 			Str("path", r.URL.Path).
 			Str("query", r.URL.RawQuery).
@@ -28,9 +41,9 @@ func LoggingMiddleware(next http.HandlerFunc) http.HandlerFunc {
 
 func HandleLatestLaunch(client SpaceXClientInterface) http.HandlerFunc {
 	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
-		launch, err := client.GetLatestLaunch()
+		launch, err := client.GetLatestLaunch(r.Context())
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
 
@@ -43,13 +56,13 @@ func HandleRocket(client SpaceXClientInterface) http.HandlerFunc {
 	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
 		rocketID := r.URL.Query().Get("id")
 		if rocketID == "" {
-			http.Error(w, "rocket ID is required", http.StatusBadRequest)
+			writeBadRequest(w, r, "rocket ID is required")
 			return
 		}
 
-		rocket, err := client.GetRocket(rocketID)
+		rocket, err := client.GetRocket(r.Context(), rocketID)
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
 
@@ -58,11 +71,31 @@ func HandleRocket(client SpaceXClientInterface) http.HandlerFunc {
 	})
 }
 
+// BatchRocketsResponse is returned by /api/rockets?ids=...
+type BatchRocketsResponse struct {
+	Rockets []BatchRocket `json:"rockets"`
+}
+
+// BatchRocket is the result for one requested rocket ID. Exactly one of
+// Rocket and Error is set.
+type BatchRocket struct {
+	ID     string   `json:"id"`
+	Rocket *Rocket  `json:"rocket,omitempty"`
+	Error  *Problem `json:"error,omitempty"`
+}
+
+// HandleListRockets lists all rockets, or with ?ids=a,b,c fetches just those
+// rockets concurrently, reporting failures per ID
 func HandleListRockets(client SpaceXClientInterface) http.HandlerFunc {
 	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
-		rockets, err := client.GetAllRockets()
+		if r.URL.Query().Has("ids") {
+			handleBatchRockets(w, r, client)
+			return
+		}
+
+		rockets, err := client.GetAllRockets(r.Context())
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
 
@@ -71,11 +104,254 @@ func HandleListRockets(client SpaceXClientInterface) http.HandlerFunc {
 	})
 }
 
+func handleBatchRockets(w http.ResponseWriter, r *http.Request, client SpaceXClientInterface) {
+	ids := strings.Split(r.URL.Query().Get("ids"), ",")
+	for i := range ids {
+		ids[i] = strings.TrimSpace(ids[i])
+	}
+	if err := ValidateBatchIDs(ids); err != nil {
+		writeBadRequest(w, r, err.Error())
+		return
+	}
+
+	results := GetRocketsByID(r.Context(), client, ids)
+	resp := BatchRocketsResponse{Rockets: make([]BatchRocket, len(results))}
+	for i, result := range results {
+		resp.Rockets[i] = BatchRocket{ID: result.ID, Rocket: result.Rocket}
+		if result.Err != nil {
+			resp.Rockets[i].Error = itemProblem(r, result.ID, result.Err)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func HandleListCapsules(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		capsules, err := client.GetAllCapsules(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(capsules)
+	})
+}
+
+func HandleCapsule(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		capsuleID := r.URL.Query().Get("id")
+		if capsuleID == "" {
+			writeBadRequest(w, r, "capsule ID is required")
+			return
+		}
+
+		capsule, err := client.GetCapsule(r.Context(), capsuleID)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(capsule)
+	})
+}
+
+func HandleListCores(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		cores, err := client.GetAllCores(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(cores)
+	})
+}
+
+func HandleListLaunchpads(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		near, err := ParseNearQuery(r.URL.Query())
+		if err != nil {
+			writeBadRequest(w, r, err.Error())
+			return
+		}
+
+		launchpads, err := client.GetAllLaunchpads(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if near != nil {
+			json.NewEncoder(w).Encode(LaunchpadsNear(launchpads, *near))
+			return
+		}
+		json.NewEncoder(w).Encode(launchpads)
+	})
+}
+
+func HandleLaunchpad(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		launchpadID := r.URL.Query().Get("id")
+		if launchpadID == "" {
+			writeBadRequest(w, r, "launchpad ID is required")
+			return
+		}
+
+		launchpad, err := client.GetLaunchpad(r.Context(), launchpadID)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(launchpad)
+	})
+}
+
+func HandleListLandpads(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		landpads, err := client.GetAllLandpads(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(landpads)
+	})
+}
+
+func HandleListCrew(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		crew, err := client.GetAllCrew(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(crew)
+	})
+}
+
+// HandleNextLaunch returns the next upcoming launch with the time left until it
+func HandleNextLaunch(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		launch, err := client.GetNextLaunch(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		countdown, err := NewLaunchCountdown(*launch, time.Now())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(countdown)
+	})
+}
+
+// HandleUpcomingLaunches returns all upcoming launches, soonest first, each
+// with the time left until it. Launches with an unparsable date are logged
+// and left out.
+func HandleUpcomingLaunches(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		launches, err := client.GetUpcomingLaunches(r.Context())
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		now := time.Now()
+		countdowns := make([]*LaunchCountdown, 0, len(launches))
+		for _, launch := range launches {
+			countdown, err := NewLaunchCountdown(launch, now)
+			if err != nil {
+				// One bad date should not hide every other launch
+				log.Warn().
+					Err(err).
+					Str("request_id", requestid.FromContext(r.Context())).
+					Str("path", r.URL.Path).
+					Str("id", launch.ID).
+					Msg("Skipping launch without a valid date")
+				continue
+			}
+			countdowns = append(countdowns, countdown)
+		}
+		// SpaceX lists them by flight number, which placeholder dates do not
+		// always follow
+		sort.SliceStable(countdowns, func(i, j int) bool {
+			return countdowns[i].SecondsUntilLaunch < countdowns[j].SecondsUntilLaunch
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(countdowns)
+	})
+}
+
+// HandleLaunch returns all details of the launch with the given id. With
+// populate=true the rocket, launchpad, payloads and crew are included instead
+// of only their IDs.
+func HandleLaunch(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		launchID := r.URL.Query().Get("id")
+		if launchID == "" {
+			writeBadRequest(w, r, "launch ID is required")
+			return
+		}
+		populate := false
+		if v := r.URL.Query().Get("populate"); v != "" {
+			var err error
+			if populate, err = strconv.ParseBool(v); err != nil {
+				writeBadRequest(w, r, "populate must be true or false")
+				return
+			}
+		}
+
+		launch, err := client.GetLaunch(r.Context(), launchID, populate)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(launch)
+	})
+}
+
+// HandleListLaunches lists one page of launches, filtered and ordered by the
+// parameters read by ParseLaunchQuery
+func HandleListLaunches(client SpaceXClientInterface) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		query, err := ParseLaunchQuery(r.URL.Query())
+		if err != nil {
+			writeBadRequest(w, r, err.Error())
+			return
+		}
+
+		page, err := client.ListLaunches(r.Context(), query)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(page)
+	})
+}
+
 func HandleNumbers(client NumbersClientInterface) http.HandlerFunc {
 	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
-		mathFact, err := client.GetMathFact()
+		mathFact, err := client.GetMathFact(r.Context())
 		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			writeError(w, r, err)
 			return
 		}
 
@@ -86,12 +362,15 @@ func HandleNumbers(client NumbersClientInterface) http.HandlerFunc {
 
 func HandleNASA(client NASAClientInterface) http.HandlerFunc {
 	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
-		apod, err := client.GetAPOD()
+		date := r.URL.Query().Get("date")
+		if !ValidAPODDate(date) {
+			writeBadRequest(w, r, "date must be formatted as YYYY-MM-DD")
+			return
+		}
+
+		apod, err := client.GetAPOD(r.Context(), date)
 		if err != nil {
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(http.StatusInternalServerError)
-			errorResponse := map[string]string{"error": "unknown error"}
-			json.NewEncoder(w).Encode(errorResponse)
+			writeError(w, r, err)
 			return
 		}
 
@@ -100,15 +379,45 @@ func HandleNASA(client NASAClientInterface) http.HandlerFunc {
 	})
 }
 
+// StatusResponse reports the health of the upstream APIs
+type StatusResponse struct {
+	Upstreams []upstream.BreakerStatus `json:"upstreams"`
+}
+
+func HandleStatus(breakers ...*upstream.Breaker) http.HandlerFunc {
+	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		status := StatusResponse{Upstreams: make([]upstream.BreakerStatus, len(breakers))}
+		for i, breaker := range breakers {
+			status.Upstreams[i] = breaker.Status()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(status)
+	})
+}
+
 func HandleRoot() http.HandlerFunc {
 	return LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
 		endpoints := map[string]string{
-			"/":                  "Shows this list of available endpoints",
-			"/api/latest-launch": "Get the latest SpaceX launch",
-			"/api/rocket":        "Get a specific rocket by ID (use ?id=[rocket_id])",
-			"/api/rockets":       "Get a list of all SpaceX rockets",
-			"/api/numbers":       "Get a random math fact",
-			"/api/nasa":          "Get NASA's Astronomy Picture of the Day",
+			"/":                      "Shows this list of available endpoints",
+			"/api/latest-launch":     "Get the latest SpaceX launch",
+			"/api/next-launch":       "Get the next upcoming SpaceX launch with the time left until it",
+			"/api/upcoming-launches": "Get all upcoming SpaceX launches, soonest first, with the time left until each",
+			"/api/launch":            "Get all details of a specific launch by ID (use ?id=[launch_id], optionally with populate=true to include the rocket, launchpad, payloads and crew)",
+			"/api/launches":          "List SpaceX launches a page at a time (optionally use ?page=, limit=, from=, to=, success=, upcoming=, rocket= and sort=asc|desc)",
+			"/api/rocket":            "Get a specific rocket by ID (use ?id=[rocket_id])",
+			"/api/rockets":           "Get a list of all SpaceX rockets (or only some with ?ids=[id1],[id2])",
+			"/api/capsules":          "Get a list of all SpaceX Dragon capsules with their status and reuse count",
+			"/api/capsule":           "Get a specific capsule by ID (use ?id=[capsule_id])",
+			"/api/cores":             "Get a list of all SpaceX first stage cores with their status, reuse count and landings",
+			"/api/crew":              "Get a list of all SpaceX crew members with their status and launches",
+			"/api/launchpads":        "Get a list of all SpaceX launchpads with their location and launch record (use ?near=[lat],[lon] and optionally radius_km= to list the closest first)",
+			"/api/launchpad":         "Get a specific launchpad by ID (use ?id=[launchpad_id])",
+			"/api/landpads":          "Get a list of all SpaceX landing zones and drone ships with their location and landing record",
+			"/api/numbers":           "Get a random math fact",
+			"/api/nasa":              "Get NASA's Astronomy Picture of the Day (optionally use ?date=YYYY-MM-DD)",
+			"/api/status":            "Get the circuit breaker state of each upstream API",
+			"/debug/vars":            "Get gRPC call counts and latency by method and status code, with Go runtime stats, as expvar JSON",
 		}
 
 		w.Header().Set("Content-Type", "application/json")
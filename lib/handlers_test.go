@@ -1,15 +1,25 @@
 package lib
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"outerspace-go/lib/requestid"
+	"outerspace-go/lib/upstream"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // Mock SpaceX client
@@ -17,40 +27,163 @@ type MockSpaceXClient struct {
 	mock.Mock
 }
 
-func (m *MockSpaceXClient) GetAllRockets() ([]RocketSummary, error) {
-	args := m.Called()
+func (m *MockSpaceXClient) GetAllRockets(ctx context.Context) ([]RocketSummary, error) {
+	args := m.Called(ctx)
 	return args.Get(0).([]RocketSummary), args.Error(1)
 }
 
-func (m *MockSpaceXClient) GetRocket(id string) (*Rocket, error) {
-	args := m.Called(id)
+func (m *MockSpaceXClient) GetRocket(ctx context.Context, id string) (*Rocket, error) {
+	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*Rocket), args.Error(1)
 }
 
-func (m *MockSpaceXClient) GetLatestLaunch() (*Launch, error) {
-	args := m.Called()
+func (m *MockSpaceXClient) GetAllCapsules(ctx context.Context) ([]Capsule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Capsule), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetCapsule(ctx context.Context, id string) (*Capsule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Capsule), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllCores(ctx context.Context) ([]Core, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Core), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllCrew(ctx context.Context) ([]CrewMember, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]CrewMember), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllLaunchpads(ctx context.Context) ([]Launchpad, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Launchpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLaunchpad(ctx context.Context, id string) (*Launchpad, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Launchpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetAllLandpads(ctx context.Context) ([]Landpad, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Landpad), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLatestLaunch(ctx context.Context) (*Launch, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Launch), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetNextLaunch(ctx context.Context) (*Launch, error) {
+	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*Launch), args.Error(1)
 }
 
+func (m *MockSpaceXClient) GetUpcomingLaunches(ctx context.Context) ([]Launch, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]Launch), args.Error(1)
+}
+
+func (m *MockSpaceXClient) ListLaunches(ctx context.Context, query LaunchQuery) (*LaunchPage, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*LaunchPage), args.Error(1)
+}
+
+func (m *MockSpaceXClient) GetLaunch(ctx context.Context, id string, populate bool) (*LaunchDetail, error) {
+	args := m.Called(ctx, id, populate)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*LaunchDetail), args.Error(1)
+}
+
 // Mock Numbers client
 type MockNumbersClient struct {
 	mock.Mock
 }
 
-func (m *MockNumbersClient) GetMathFact() (*MathFact, error) {
-	args := m.Called()
+func (m *MockNumbersClient) GetMathFact(ctx context.Context) (*MathFact, error) {
+	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*MathFact), args.Error(1)
 }
 
+// Mock NASA client
+type MockNASAClient struct {
+	mock.Mock
+}
+
+func (m *MockNASAClient) GetAPOD(ctx context.Context, date string) (*APOD, error) {
+	args := m.Called(ctx, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*APOD), args.Error(1)
+}
+
+func TestLoggingMiddleware_RequestID(t *testing.T) {
+	var seen string
+	handler := LoggingMiddleware(func(w http.ResponseWriter, r *http.Request) {
+		seen = requestid.FromContext(r.Context())
+	})
+
+	// A request id sent by the caller is kept
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set("X-Request-Id", "req-42")
+	w := httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, "req-42", seen)
+	assert.Equal(t, "req-42", w.Header().Get("X-Request-Id"))
+
+	// Otherwise a new one is generated
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest("GET", "/", nil))
+	assert.NotEmpty(t, seen)
+	assert.NotEqual(t, "req-42", seen)
+	assert.Equal(t, seen, w.Header().Get("X-Request-Id"))
+}
+
 func TestHandleRoot(t *testing.T) {
 	req := httptest.NewRequest("GET", "/", nil)
 	w := httptest.NewRecorder()
@@ -70,9 +203,59 @@ func TestHandleRoot(t *testing.T) {
 	assert.Contains(t, endpoints, "/api/rockets")
 	assert.Contains(t, endpoints, "/api/rocket")
 	assert.Contains(t, endpoints, "/api/latest-launch")
+	assert.Contains(t, endpoints, "/api/capsules")
+	assert.Contains(t, endpoints, "/api/capsule")
+	assert.Contains(t, endpoints, "/api/cores")
+	assert.Contains(t, endpoints, "/api/crew")
+	assert.Contains(t, endpoints, "/api/launchpads")
+	assert.Contains(t, endpoints, "/api/launchpad")
+	assert.Contains(t, endpoints, "/api/landpads")
+	assert.Contains(t, endpoints, "/api/next-launch")
+	assert.Contains(t, endpoints, "/api/upcoming-launches")
+	assert.Contains(t, endpoints, "/api/launch")
+	assert.Contains(t, endpoints, "/api/launches")
 	assert.Contains(t, endpoints, "/api/numbers")
 }
 
+func TestHandleStatus(t *testing.T) {
+	spacex := upstream.NewBreaker("spacex", upstream.DefaultBreakerSettings())
+	numbers := upstream.NewBreaker("numbers", upstream.BreakerSettings{
+		Window:      time.Minute,
+		MinRequests: 1,
+		FailureRate: 0.5,
+		CoolDown:    time.Minute,
+	})
+	done, err := numbers.Allow()
+	assert.NoError(t, err)
+	done(upstream.OutcomeFailure)
+
+	req := httptest.NewRequest("GET", "/api/status", nil)
+	w := httptest.NewRecorder()
+
+	HandleStatus(spacex, numbers)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var status struct {
+		Upstreams []struct {
+			Upstream string `json:"upstream"`
+			State    string `json:"state"`
+			Failures int    `json:"failures"`
+		} `json:"upstreams"`
+	}
+	json.NewDecoder(resp.Body).Decode(&status)
+
+	assert.Len(t, status.Upstreams, 2)
+	assert.Equal(t, "spacex", status.Upstreams[0].Upstream)
+	assert.Equal(t, "closed", status.Upstreams[0].State)
+	assert.Equal(t, "numbers", status.Upstreams[1].Upstream)
+	assert.Equal(t, "open", status.Upstreams[1].State)
+	assert.Equal(t, 1, status.Upstreams[1].Failures)
+}
+
 func TestHandleListRockets(t *testing.T) {
 	mockClient := new(MockSpaceXClient)
 	mockRockets := []RocketSummary{
@@ -80,7 +263,7 @@ func TestHandleListRockets(t *testing.T) {
 		{ID: "456", Name: "Falcon Heavy"},
 	}
 
-	mockClient.On("GetAllRockets").Return(mockRockets, nil)
+	mockClient.On("GetAllRockets", mock.Anything).Return(mockRockets, nil)
 
 	req := httptest.NewRequest("GET", "/api/rockets", nil)
 	w := httptest.NewRecorder()
@@ -104,6 +287,61 @@ func TestHandleListRockets(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestHandleListRockets_IDs(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetRocket", mock.Anything, "falcon9").Return(&Rocket{ID: "falcon9", Name: "Falcon 9"}, nil)
+	mockClient.On("GetRocket", mock.Anything, "unknown").Return(nil, &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrNotFound, StatusCode: http.StatusNotFound})
+	mockClient.On("GetRocket", mock.Anything, "starship").Return(&Rocket{ID: "starship", Name: "Starship"}, nil)
+
+	req := httptest.NewRequest("GET", "/api/rockets?ids=starship,unknown,%20falcon9", nil)
+	w := httptest.NewRecorder()
+
+	HandleListRockets(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var batch BatchRocketsResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&batch))
+	require.Len(t, batch.Rockets, 3)
+	assert.Equal(t, "starship", batch.Rockets[0].ID)
+	assert.Equal(t, "Starship", batch.Rockets[0].Rocket.Name)
+	assert.Nil(t, batch.Rockets[0].Error)
+	assert.Equal(t, "unknown", batch.Rockets[1].ID)
+	assert.Nil(t, batch.Rockets[1].Rocket)
+	require.NotNil(t, batch.Rockets[1].Error)
+	assert.Equal(t, http.StatusNotFound, batch.Rockets[1].Error.Status)
+	assert.Equal(t, CodeUpstreamNotFound, batch.Rockets[1].Error.Code)
+	assert.Equal(t, SpaceXUpstream, batch.Rockets[1].Error.Upstream)
+	assert.Equal(t, "falcon9", batch.Rockets[2].ID)
+
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "GetAllRockets", mock.Anything)
+}
+
+func TestHandleListRockets_InvalidIDs(t *testing.T) {
+	for _, query := range []string{"ids=", "ids=a,,b"} {
+		t.Run(query, func(t *testing.T) {
+			mockClient := new(MockSpaceXClient)
+
+			req := httptest.NewRequest("GET", "/api/rockets?"+query, nil)
+			w := httptest.NewRecorder()
+
+			HandleListRockets(mockClient)(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+			var problem Problem
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+			assert.Equal(t, CodeInvalidArgument, problem.Code)
+			mockClient.AssertNotCalled(t, "GetRocket", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestHandleRocket(t *testing.T) {
 	mockClient := new(MockSpaceXClient)
 	mockRocket := &Rocket{
@@ -118,7 +356,7 @@ func TestHandleRocket(t *testing.T) {
 		}{Kg: 549054},
 	}
 
-	mockClient.On("GetRocket", "123").Return(mockRocket, nil)
+	mockClient.On("GetRocket", mock.Anything, "123").Return(mockRocket, nil)
 
 	req := httptest.NewRequest("GET", "/api/rocket?id=123", nil)
 	w := httptest.NewRecorder()
@@ -154,13 +392,19 @@ func TestHandleRocket_MissingID(t *testing.T) {
 	defer resp.Body.Close()
 
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, ProblemContentType, resp.Header.Get("Content-Type"))
+
+	var problem Problem
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, CodeInvalidArgument, problem.Code)
+	assert.Equal(t, "rocket ID is required", problem.Detail)
 
 	mockClient.AssertNotCalled(t, "GetRocket")
 }
 
 func TestHandleRocket_Error(t *testing.T) {
 	mockClient := new(MockSpaceXClient)
-	mockClient.On("GetRocket", "999").Return(nil, errors.New("not found"))
+	mockClient.On("GetRocket", mock.Anything, "999").Return(nil, errors.New("not found"))
 
 	req := httptest.NewRequest("GET", "/api/rocket?id=999", nil)
 	w := httptest.NewRecorder()
@@ -173,20 +417,95 @@ func TestHandleRocket_Error(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 
+	// Internal error messages are never sent to callers
+	var problem Problem
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, CodeInternal, problem.Code)
+	assert.NotContains(t, problem.Detail, "not found")
+
 	mockClient.AssertExpectations(t)
 }
 
+func TestHandleRocket_CallerCanceled(t *testing.T) {
+	var logs bytes.Buffer
+	orig := log.Logger
+	log.Logger = zerolog.New(&logs).Level(zerolog.InfoLevel)
+	t.Cleanup(func() { log.Logger = orig })
+
+	mockClient := new(MockSpaceXClient)
+	// upstream.Classify returns the caller's cancellation unchanged
+	mockClient.On("GetRocket", mock.Anything, "999").Return(nil, fmt.Errorf("fetching rocket: %w", context.Canceled))
+
+	req := httptest.NewRequest("GET", "/api/rocket?id=999", nil)
+	w := httptest.NewRecorder()
+
+	HandleRocket(mockClient)(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, StatusClientClosedRequest, resp.StatusCode)
+
+	var problem Problem
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, CodeCanceled, problem.Code)
+	assert.Equal(t, "Client Closed Request", problem.Title)
+	// Only the Inbound line is logged, not a server error
+	assert.NotContains(t, logs.String(), `"level":"error"`)
+	assert.NotContains(t, logs.String(), "Request failed")
+}
+
+func TestHandleRocket_UpstreamErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"not found", &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrNotFound, StatusCode: http.StatusNotFound}, http.StatusNotFound, CodeUpstreamNotFound},
+		{"rate limited", &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrRateLimited, StatusCode: http.StatusTooManyRequests}, http.StatusTooManyRequests, CodeUpstreamRateLimited},
+		{"unavailable", &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrUnavailable, StatusCode: http.StatusServiceUnavailable}, http.StatusBadGateway, CodeUpstreamUnavailable},
+		{"bad payload", upstream.BadPayload(SpaceXUpstream, io.ErrUnexpectedEOF), http.StatusBadGateway, CodeUpstreamBadPayload},
+		{"timeout", &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrTimeout, Err: context.DeadlineExceeded}, http.StatusGatewayTimeout, CodeUpstreamTimeout},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockSpaceXClient)
+			mockClient.On("GetRocket", mock.Anything, "999").Return(nil, tt.err)
+
+			req := httptest.NewRequest("GET", "/api/rocket?id=999", nil)
+			req.Header.Set("X-Request-Id", "req-42")
+			w := httptest.NewRecorder()
+
+			HandleRocket(mockClient)(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, tt.status, resp.StatusCode)
+			assert.Equal(t, ProblemContentType, resp.Header.Get("Content-Type"))
+
+			var problem Problem
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+			assert.Equal(t, tt.status, problem.Status)
+			assert.Equal(t, http.StatusText(tt.status), problem.Title)
+			assert.Equal(t, tt.code, problem.Code)
+			assert.Equal(t, SpaceXUpstream, problem.Upstream)
+			assert.Equal(t, "req-42", problem.RequestID)
+			assert.Equal(t, "/api/rocket", problem.Instance)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
 func TestHandleLatestLaunch(t *testing.T) {
 	mockClient := new(MockSpaceXClient)
+	success := true
 	mockLaunch := &Launch{
 		FlightNumber: 100,
 		MissionName:  "Mission X",
 		DateUTC:      "2023-01-01T12:00:00Z",
-		Success:      true,
+		Success:      &success,
 		Details:      "Test mission",
 	}
 
-	mockClient.On("GetLatestLaunch").Return(mockLaunch, nil)
+	mockClient.On("GetLatestLaunch", mock.Anything).Return(mockLaunch, nil)
 
 	req := httptest.NewRequest("GET", "/api/latest-launch", nil)
 	w := httptest.NewRecorder()
@@ -208,6 +527,531 @@ func TestHandleLatestLaunch(t *testing.T) {
 	mockClient.AssertExpectations(t)
 }
 
+func TestHandleLatestLaunch_PassesRequestContext(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "inbound")
+	req := httptest.NewRequest("GET", "/api/latest-launch", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+
+	mockClient.On("GetLatestLaunch", mock.MatchedBy(func(got context.Context) bool {
+		return got.Value(ctxKey{}) == "inbound"
+	})).Return(&Launch{FlightNumber: 1}, nil)
+
+	handler := HandleLatestLaunch(mockClient)
+	handler(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleListCapsules(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllCapsules", mock.Anything).Return([]Capsule{
+		{ID: "1", Serial: "C101", Status: "retired"},
+		{ID: "2", Serial: "C206", Status: "active", ReuseCount: 2},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/capsules", nil)
+	w := httptest.NewRecorder()
+
+	HandleListCapsules(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var capsules []Capsule
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&capsules))
+	require.Len(t, capsules, 2)
+	assert.Equal(t, "C206", capsules[1].Serial)
+	assert.Equal(t, 2, capsules[1].ReuseCount)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleCapsule(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetCapsule", mock.Anything, "2").Return(&Capsule{ID: "2", Serial: "C206"}, nil)
+
+	req := httptest.NewRequest("GET", "/api/capsule?id=2", nil)
+	w := httptest.NewRecorder()
+
+	HandleCapsule(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var capsule Capsule
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&capsule))
+	assert.Equal(t, "C206", capsule.Serial)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleCapsule_MissingID(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+
+	req := httptest.NewRequest("GET", "/api/capsule", nil)
+	w := httptest.NewRecorder()
+
+	HandleCapsule(mockClient)(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var problem Problem
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, "capsule ID is required", problem.Detail)
+	mockClient.AssertNotCalled(t, "GetCapsule")
+}
+
+func TestHandleListCores(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllCores", mock.Anything).Return([]Core{{ID: "1", Serial: "B1058", Block: 5, ASDSLandings: 14}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/cores", nil)
+	w := httptest.NewRecorder()
+
+	HandleListCores(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var cores []Core
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&cores))
+	require.Len(t, cores, 1)
+	assert.Equal(t, 14, cores[0].ASDSLandings)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleListCrew(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllCrew", mock.Anything).Return([]CrewMember{{ID: "1", Name: "Robert Behnken", Status: "active"}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/crew", nil)
+	w := httptest.NewRecorder()
+
+	HandleListCrew(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var crew []CrewMember
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&crew))
+	require.Len(t, crew, 1)
+	assert.Equal(t, "Robert Behnken", crew[0].Name)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleListCrew_Error(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllCrew", mock.Anything).Return(nil, &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrRateLimited, StatusCode: 429})
+
+	req := httptest.NewRequest("GET", "/api/crew", nil)
+	w := httptest.NewRecorder()
+
+	HandleListCrew(mockClient)(w, req)
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Result().StatusCode)
+}
+
+func TestHandleListLaunchpads(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllLaunchpads", mock.Anything).Return([]Launchpad{
+		{ID: "vafb", Name: "VAFB SLC 4E", Latitude: 34.632093, Longitude: -120.610829},
+		{ID: "ksc", Name: "KSC LC 39A", Latitude: 28.6080585, Longitude: -80.6039558, LaunchAttempts: 55},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/launchpads", nil)
+	w := httptest.NewRecorder()
+
+	HandleListLaunchpads(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var launchpads []map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&launchpads))
+	require.Len(t, launchpads, 2)
+	assert.Equal(t, "vafb", launchpads[0]["id"])
+	assert.Equal(t, float64(55), launchpads[1]["launch_attempts"])
+	assert.NotContains(t, launchpads[0], "distance_km")
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleListLaunchpads_Near(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllLaunchpads", mock.Anything).Return([]Launchpad{
+		{ID: "vafb", Name: "VAFB SLC 4E", Latitude: 34.632093, Longitude: -120.610829},
+		{ID: "boca", Name: "STLS", Latitude: 25.9972641, Longitude: -97.1560845},
+		{ID: "ksc", Name: "KSC LC 39A", Latitude: 28.6080585, Longitude: -80.6039558},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/launchpads?near=28.5383,-81.3792&radius_km=2000", nil)
+	w := httptest.NewRecorder()
+
+	HandleListLaunchpads(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var launchpads []NearbyLaunchpad
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&launchpads))
+	require.Len(t, launchpads, 2)
+	assert.Equal(t, "ksc", launchpads[0].ID)
+	assert.Equal(t, "boca", launchpads[1].ID)
+	assert.Greater(t, launchpads[1].DistanceKm, launchpads[0].DistanceKm)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleListLaunchpads_InvalidNear(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+
+	req := httptest.NewRequest("GET", "/api/launchpads?near=95,0", nil)
+	w := httptest.NewRecorder()
+
+	HandleListLaunchpads(mockClient)(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var problem Problem
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, CodeInvalidArgument, problem.Code)
+	assert.Contains(t, problem.Detail, "latitude")
+	mockClient.AssertNotCalled(t, "GetAllLaunchpads")
+}
+
+func TestHandleLaunchpad(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetLaunchpad", mock.Anything, "ksc").Return(&Launchpad{ID: "ksc", Name: "KSC LC 39A"}, nil)
+
+	req := httptest.NewRequest("GET", "/api/launchpad?id=ksc", nil)
+	w := httptest.NewRecorder()
+
+	HandleLaunchpad(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var launchpad Launchpad
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&launchpad))
+	assert.Equal(t, "KSC LC 39A", launchpad.Name)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleLaunchpad_MissingID(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+
+	req := httptest.NewRequest("GET", "/api/launchpad", nil)
+	w := httptest.NewRecorder()
+
+	HandleLaunchpad(mockClient)(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var problem Problem
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, "launchpad ID is required", problem.Detail)
+	mockClient.AssertNotCalled(t, "GetLaunchpad")
+}
+
+func TestHandleListLandpads(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetAllLandpads", mock.Anything).Return([]Landpad{{ID: "lz1", Name: "LZ-1", Type: "RTLS", LandingAttempts: 15, LandingSuccesses: 14}}, nil)
+
+	req := httptest.NewRequest("GET", "/api/landpads", nil)
+	w := httptest.NewRecorder()
+
+	HandleListLandpads(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var landpads []Landpad
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&landpads))
+	require.Len(t, landpads, 1)
+	assert.Equal(t, "RTLS", landpads[0].Type)
+	assert.Equal(t, 14, landpads[0].LandingSuccesses)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleNextLaunch(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	date := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+	mockClient.On("GetNextLaunch", mock.Anything).Return(&Launch{ID: "abc", MissionName: "USSF-44", DateUTC: date, DatePrecision: "hour"}, nil)
+
+	req := httptest.NewRequest("GET", "/api/next-launch", nil)
+	w := httptest.NewRecorder()
+
+	HandleNextLaunch(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var countdown LaunchCountdown
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&countdown))
+	assert.Equal(t, "USSF-44", countdown.MissionName)
+	assert.Equal(t, "hour", countdown.DatePrecision)
+	assert.InDelta(t, 48*3600, countdown.SecondsUntilLaunch, 5)
+	assert.NotEmpty(t, countdown.TimeUntilLaunch)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleNextLaunch_InvalidDate(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetNextLaunch", mock.Anything).Return(&Launch{ID: "abc", DateUTC: "TBD"}, nil)
+
+	req := httptest.NewRequest("GET", "/api/next-launch", nil)
+	w := httptest.NewRecorder()
+
+	HandleNextLaunch(mockClient)(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+
+	var problem Problem
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, CodeUpstreamBadPayload, problem.Code)
+}
+
+func TestHandleUpcomingLaunches(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	soon := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	later := time.Now().Add(30 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	mockClient.On("GetUpcomingLaunches", mock.Anything).Return([]Launch{
+		{ID: "a", DateUTC: soon, DatePrecision: "hour"},
+		{ID: "b", DateUTC: later, DatePrecision: "month"},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/upcoming-launches", nil)
+	w := httptest.NewRecorder()
+
+	HandleUpcomingLaunches(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var countdowns []LaunchCountdown
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&countdowns))
+	require.Len(t, countdowns, 2)
+	assert.Equal(t, "a", countdowns[0].ID)
+	assert.InDelta(t, 3600, countdowns[0].SecondsUntilLaunch, 5)
+	// An upcoming launch has no outcome yet rather than a failed one
+	assert.Nil(t, countdowns[0].Success)
+	assert.Equal(t, "month", countdowns[1].DatePrecision)
+	assert.InDelta(t, 30*24*3600, countdowns[1].SecondsUntilLaunch, 5)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleUpcomingLaunches_SortsByDate(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	now := time.Now().UTC()
+	// A placeholder date for a later flight can come before an earlier one
+	mockClient.On("GetUpcomingLaunches", mock.Anything).Return([]Launch{
+		{ID: "quarter", FlightNumber: 1, DateUTC: now.Add(90 * 24 * time.Hour).Format(time.RFC3339), DatePrecision: "quarter"},
+		{ID: "hour", FlightNumber: 2, DateUTC: now.Add(time.Hour).Format(time.RFC3339), DatePrecision: "hour"},
+		{ID: "month", FlightNumber: 3, DateUTC: now.Add(30 * 24 * time.Hour).Format(time.RFC3339), DatePrecision: "month"},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/upcoming-launches", nil)
+	w := httptest.NewRecorder()
+
+	HandleUpcomingLaunches(mockClient)(w, req)
+
+	var countdowns []LaunchCountdown
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(&countdowns))
+	require.Len(t, countdowns, 3)
+	assert.Equal(t, "hour", countdowns[0].ID)
+	assert.Equal(t, "month", countdowns[1].ID)
+	assert.Equal(t, "quarter", countdowns[2].ID)
+}
+
+func TestHandleUpcomingLaunches_SkipsInvalidDate(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	soon := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	mockClient.On("GetUpcomingLaunches", mock.Anything).Return([]Launch{
+		{ID: "a", DateUTC: soon, DatePrecision: "hour"},
+		{ID: "b", DateUTC: "TBD", DatePrecision: "year"},
+		{ID: "c", DateUTC: soon, DatePrecision: "day"},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/upcoming-launches", nil)
+	w := httptest.NewRecorder()
+
+	HandleUpcomingLaunches(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var countdowns []LaunchCountdown
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&countdowns))
+	require.Len(t, countdowns, 2)
+	assert.Equal(t, "a", countdowns[0].ID)
+	assert.Equal(t, "c", countdowns[1].ID)
+}
+
+func TestHandleUpcomingLaunches_Empty(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetUpcomingLaunches", mock.Anything).Return([]Launch{}, nil)
+
+	req := httptest.NewRequest("GET", "/api/upcoming-launches", nil)
+	w := httptest.NewRecorder()
+
+	HandleUpcomingLaunches(mockClient)(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	assert.JSONEq(t, "[]", w.Body.String())
+}
+
+func TestHandleLaunch(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetLaunch", mock.Anything, "abc", true).Return(&LaunchDetail{
+		ID:          "abc",
+		MissionName: "Crew-1",
+		Rocket:      Ref[Rocket]{ID: "falcon9", Value: &Rocket{ID: "falcon9", Name: "Falcon 9"}},
+		Launchpad:   Ref[Launchpad]{ID: "39a"},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/launch?id=abc&populate=true", nil)
+	w := httptest.NewRecorder()
+
+	HandleLaunch(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var launch map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&launch))
+	assert.Equal(t, "Crew-1", launch["name"])
+	// A populated reference is the document, the others stay IDs
+	assert.Equal(t, "Falcon 9", launch["rocket"].(map[string]any)["name"])
+	assert.Equal(t, "39a", launch["launchpad"])
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleLaunch_InvalidQuery(t *testing.T) {
+	for _, query := range []string{"", "populate=true", "id=abc&populate=maybe"} {
+		t.Run(query, func(t *testing.T) {
+			mockClient := new(MockSpaceXClient)
+
+			req := httptest.NewRequest("GET", "/api/launch?"+query, nil)
+			w := httptest.NewRecorder()
+
+			HandleLaunch(mockClient)(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+			var problem Problem
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+			assert.Equal(t, CodeInvalidArgument, problem.Code)
+			mockClient.AssertNotCalled(t, "GetLaunch", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHandleLaunch_NotFound(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("GetLaunch", mock.Anything, "unknown", false).Return(nil, &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrNotFound, StatusCode: 404})
+
+	req := httptest.NewRequest("GET", "/api/launch?id=unknown", nil)
+	w := httptest.NewRecorder()
+
+	HandleLaunch(mockClient)(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleListLaunches(t *testing.T) {
+	mockClient := new(MockSpaceXClient)
+	mockClient.On("ListLaunches", mock.Anything, mock.MatchedBy(func(query LaunchQuery) bool {
+		return query.Page == 2 && query.Limit == 5 && query.RocketID == "falcon9" && query.Ascending
+	})).Return(&LaunchPage{
+		Launches:      []Launch{{ID: "abc", FlightNumber: 10, MissionName: "CRS-1"}},
+		Page:          2,
+		Limit:         5,
+		TotalLaunches: 6,
+		TotalPages:    2,
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/launches?page=2&limit=5&rocket=falcon9&sort=asc", nil)
+	w := httptest.NewRecorder()
+
+	HandleListLaunches(mockClient)(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var page LaunchPage
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	require.Len(t, page.Launches, 1)
+	assert.Equal(t, "abc", page.Launches[0].ID)
+	assert.Equal(t, 6, page.TotalLaunches)
+	assert.False(t, page.HasNextPage)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleListLaunches_InvalidQuery(t *testing.T) {
+	for _, query := range []string{"page=0", "limit=1000", "from=soon", "success=maybe", "sort=random"} {
+		t.Run(query, func(t *testing.T) {
+			mockClient := new(MockSpaceXClient)
+
+			req := httptest.NewRequest("GET", "/api/launches?"+query, nil)
+			w := httptest.NewRecorder()
+
+			HandleListLaunches(mockClient)(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+			var problem Problem
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+			assert.Equal(t, CodeInvalidArgument, problem.Code)
+			mockClient.AssertNotCalled(t, "ListLaunches", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestHandleNumbers(t *testing.T) {
 	mockClient := new(MockNumbersClient)
 	mockFact := &MathFact{
@@ -217,7 +1061,7 @@ func TestHandleNumbers(t *testing.T) {
 		Type:   "math",
 	}
 
-	mockClient.On("GetMathFact").Return(mockFact, nil)
+	mockClient.On("GetMathFact", mock.Anything).Return(mockFact, nil)
 
 	req := httptest.NewRequest("GET", "/api/numbers", nil)
 	w := httptest.NewRecorder()
@@ -239,3 +1083,66 @@ func TestHandleNumbers(t *testing.T) {
 
 	mockClient.AssertExpectations(t)
 }
+
+func TestHandleNASA_Error(t *testing.T) {
+	mockClient := new(MockNASAClient)
+	mockClient.On("GetAPOD", mock.Anything, "").Return(nil, &upstream.Error{Upstream: NASAUpstream, Kind: upstream.ErrRateLimited, StatusCode: http.StatusTooManyRequests})
+
+	req := httptest.NewRequest("GET", "/api/nasa", nil)
+	w := httptest.NewRecorder()
+
+	handler := HandleNASA(mockClient)
+	handler(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	var problem Problem
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&problem))
+	assert.Equal(t, CodeUpstreamRateLimited, problem.Code)
+	assert.Equal(t, NASAUpstream, problem.Upstream)
+	assert.NotEmpty(t, problem.RequestID)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleNASA_Date(t *testing.T) {
+	mockClient := new(MockNASAClient)
+	mockClient.On("GetAPOD", mock.Anything, "2024-04-08").Return(&APOD{Title: "Eclipse", Date: "2024-04-08"}, nil)
+
+	req := httptest.NewRequest("GET", "/api/nasa?date=2024-04-08", nil)
+	w := httptest.NewRecorder()
+
+	handler := HandleNASA(mockClient)
+	handler(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var apod APOD
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apod))
+	assert.Equal(t, "Eclipse", apod.Title)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestHandleNASA_InvalidDate(t *testing.T) {
+	mockClient := new(MockNASAClient)
+
+	req := httptest.NewRequest("GET", "/api/nasa?date=04/08/2024", nil)
+	w := httptest.NewRecorder()
+
+	handler := HandleNASA(mockClient)
+	handler(w, req)
+
+	resp := w.Result()
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	mockClient.AssertNotCalled(t, "GetAPOD")
+}
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -24,15 +25,32 @@ type Client struct {
 	version    string
 }
 
+// ClientOption configures the client built by NewClient
+type ClientOption func(*Client)
+
+// WithTLS sets the TLS configuration used for https:// base URLs, e.g. to
+// trust a private CA or present a client certificate, see tlsconfig.Client
+func WithTLS(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config
+		c.httpClient.Transport = transport
+	}
+}
+
 // NewClient creates a new HTTP client
-func NewClient(baseURL string) *Client {
-	return &Client{
+func NewClient(baseURL string, opts ...ClientOption) *Client {
+	c := &Client{
 		baseURL: baseURL,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 		version: getVersion(),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // getVersion reads the version from VERSION file or returns default
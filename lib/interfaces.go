@@ -1,18 +1,33 @@
 package lib
 
+import (
+	"context"
+)
+
 // SpaceXClientInterface defines the interface for SpaceX API client
 type SpaceXClientInterface interface {
-	GetAllRockets() ([]RocketSummary, error)
-	GetRocket(id string) (*Rocket, error)
-	GetLatestLaunch() (*Launch, error)
+	GetAllRockets(ctx context.Context) ([]RocketSummary, error)
+	GetRocket(ctx context.Context, id string) (*Rocket, error)
+	GetAllCapsules(ctx context.Context) ([]Capsule, error)
+	GetCapsule(ctx context.Context, id string) (*Capsule, error)
+	GetAllCores(ctx context.Context) ([]Core, error)
+	GetAllCrew(ctx context.Context) ([]CrewMember, error)
+	GetAllLaunchpads(ctx context.Context) ([]Launchpad, error)
+	GetLaunchpad(ctx context.Context, id string) (*Launchpad, error)
+	GetAllLandpads(ctx context.Context) ([]Landpad, error)
+	GetLatestLaunch(ctx context.Context) (*Launch, error)
+	GetNextLaunch(ctx context.Context) (*Launch, error)
+	GetUpcomingLaunches(ctx context.Context) ([]Launch, error)
+	ListLaunches(ctx context.Context, query LaunchQuery) (*LaunchPage, error)
+	GetLaunch(ctx context.Context, id string, populate bool) (*LaunchDetail, error)
 }
 
 // NumbersClientInterface defines the interface for Numbers API client
 type NumbersClientInterface interface {
-	GetMathFact() (*MathFact, error)
+	GetMathFact(ctx context.Context) (*MathFact, error)
 }
 
 // NASAClientInterface defines the interface for NASA API client
 type NASAClientInterface interface {
-	GetAPOD() (*APOD, error)
+	GetAPOD(ctx context.Context, date string) (*APOD, error)
 }
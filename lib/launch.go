@@ -0,0 +1,188 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"outerspace-go/lib/upstream"
+)
+
+// populatedLaunchFields are the references of a launch that GetLaunch
+// replaces with the documents they point to when asked to populate them
+var populatedLaunchFields = []string{"rocket", "launchpad", "payloads", "crew"}
+
+// Ref is a reference from a launch to another SpaceX document. It holds the
+// document's ID and, when the launch was fetched with populate, the document
+// itself. Like in the SpaceX API it is encoded as the bare ID or as the whole
+// document.
+type Ref[T any] struct {
+	ID    string
+	Value *T
+}
+
+// UnmarshalJSON accepts either an ID or a populated document with an id
+func (r *Ref[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &r.ID)
+	}
+
+	var doc struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	value := new(T)
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	r.ID, r.Value = doc.ID, value
+	return nil
+}
+
+// MarshalJSON encodes the document when it was populated and the ID otherwise
+func (r Ref[T]) MarshalJSON() ([]byte, error) {
+	if r.Value != nil {
+		return json.Marshal(r.Value)
+	}
+	if r.ID == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(r.ID)
+}
+
+// LaunchDetail is everything SpaceX knows about a launch. The rocket,
+// launchpad, payloads and crew are references that are only populated with
+// their documents on request.
+type LaunchDetail struct {
+	ID           string `json:"id"`
+	FlightNumber int    `json:"flight_number"`
+	MissionName  string `json:"name"`
+	DateUTC      string `json:"date_utc"`
+	// DatePrecision is how precisely the date is known: half, quarter, year,
+	// month, day or hour
+	DatePrecision string `json:"date_precision"`
+	// Success is unset until the outcome of the launch is known
+	Success   *bool             `json:"success"`
+	Upcoming  bool              `json:"upcoming"`
+	Details   string            `json:"details"`
+	Links     LaunchLinks       `json:"links"`
+	Failures  []LaunchFailure   `json:"failures"`
+	Cores     []LaunchCore      `json:"cores"`
+	Rocket    Ref[Rocket]       `json:"rocket"`
+	Launchpad Ref[Launchpad]    `json:"launchpad"`
+	Payloads  []Ref[Payload]    `json:"payloads"`
+	Crew      []Ref[CrewMember] `json:"crew"`
+}
+
+// LaunchLinks points to media about a launch; links SpaceX does not have are
+// empty
+type LaunchLinks struct {
+	Patch struct {
+		Small string `json:"small"`
+		Large string `json:"large"`
+	} `json:"patch"`
+	Webcast   string `json:"webcast"`
+	YouTubeID string `json:"youtube_id"`
+	Article   string `json:"article"`
+	Wikipedia string `json:"wikipedia"`
+	Presskit  string `json:"presskit"`
+}
+
+// LaunchFailure describes what went wrong during a failed launch
+type LaunchFailure struct {
+	// Time is the number of seconds after liftoff
+	Time     int    `json:"time"`
+	Altitude int    `json:"altitude"`
+	Reason   string `json:"reason"`
+}
+
+// LaunchCore is a first stage core flown on a launch and how its landing went
+type LaunchCore struct {
+	// CoreID is the ID of the core, unset for cores SpaceX could not identify
+	CoreID         string `json:"core"`
+	Flight         int    `json:"flight"`
+	Gridfins       bool   `json:"gridfins"`
+	Legs           bool   `json:"legs"`
+	Reused         bool   `json:"reused"`
+	LandingAttempt bool   `json:"landing_attempt"`
+	LandingSuccess *bool  `json:"landing_success"`
+	// LandingType is e.g. ASDS for a droneship or RTLS for a landing zone
+	LandingType string `json:"landing_type"`
+	LandpadID   string `json:"landpad"`
+}
+
+// Launchpad is a site SpaceX launches from
+type Launchpad struct {
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	FullName        string  `json:"full_name"`
+	Locality        string  `json:"locality"`
+	Region          string  `json:"region"`
+	Latitude        float64 `json:"latitude"`
+	Longitude       float64 `json:"longitude"`
+	LaunchAttempts  int     `json:"launch_attempts"`
+	LaunchSuccesses int     `json:"launch_successes"`
+	// Status is active, inactive, unknown, retired, lost or under construction
+	Status string `json:"status"`
+}
+
+// Payload is something a launch carried to orbit
+type Payload struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Reused    bool     `json:"reused"`
+	Customers []string `json:"customers"`
+	Orbit     string   `json:"orbit"`
+	MassKg    float64  `json:"mass_kg"`
+}
+
+// CrewMember is an astronaut who flew on a SpaceX launch
+type CrewMember struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Agency    string `json:"agency"`
+	Image     string `json:"image"`
+	Wikipedia string `json:"wikipedia"`
+	// Status is active, inactive, retired or unknown
+	Status string `json:"status"`
+	// Launches are the IDs of the launches the crew member flew on
+	Launches []string `json:"launches"`
+}
+
+// GetLaunch fetches all details of the launch with the given ID. With
+// populate, the rocket, launchpad, payloads and crew documents are fetched in
+// the same call through the query API instead of only their IDs.
+func (c *SpaceXClient) GetLaunch(ctx context.Context, id string, populate bool) (*LaunchDetail, error) {
+	if !populate {
+		var launch LaunchDetail
+		if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/launches/%s", c.baseURL, url.PathEscape(id)), &launch); err != nil {
+			return nil, err
+		}
+		return &launch, nil
+	}
+
+	query := queryRequest{
+		Query: map[string]any{"_id": id},
+		Options: queryOptions{
+			Page:     1,
+			Limit:    1,
+			Populate: populatedLaunchFields,
+		},
+	}
+	var resp queryResponse[LaunchDetail]
+	if err := postQuery(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/launches/query", c.baseURL), query, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Docs) == 0 {
+		// The query API answers an unknown ID with no results rather than a 404
+		return nil, &upstream.Error{Upstream: SpaceXUpstream, Kind: upstream.ErrNotFound}
+	}
+	return &resp.Docs[0], nil
+}
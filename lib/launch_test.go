@@ -0,0 +1,174 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// launchJSONFormat is a launch as SpaceX returns it, with the references
+// left to fill in as IDs or populated documents
+const launchJSONFormat = `{
+	"id": "5eb87d46ffd86e000604b388",
+	"flight_number": 94,
+	"name": "Crew-1",
+	"date_utc": "2020-11-16T00:27:00.000Z",
+	"date_precision": "hour",
+	"success": true,
+	"upcoming": false,
+	"details": null,
+	"links": {
+		"patch": {"small": "https://example.com/small.png", "large": "https://example.com/large.png"},
+		"webcast": "https://youtu.be/bnChQbxLkkI",
+		"youtube_id": "bnChQbxLkkI",
+		"article": "https://example.com/article",
+		"wikipedia": "https://en.wikipedia.org/wiki/SpaceX_Crew-1",
+		"presskit": null
+	},
+	"failures": [],
+	"cores": [{
+		"core": "5f57c5440622a633027900a0",
+		"flight": 1,
+		"gridfins": true,
+		"legs": true,
+		"reused": false,
+		"landing_attempt": true,
+		"landing_success": true,
+		"landing_type": "ASDS",
+		"landpad": "5e9e3033383ecbb9e534e7cc"
+	}],
+	"rocket": %s,
+	"launchpad": %s,
+	"payloads": %s,
+	"crew": %s
+}`
+
+func launchJSON(rocket, launchpad, payloads, crew string) string {
+	return fmt.Sprintf(launchJSONFormat, rocket, launchpad, payloads, crew)
+}
+
+func TestRef(t *testing.T) {
+	var ref Ref[Rocket]
+	require.NoError(t, json.Unmarshal([]byte(`"falcon9"`), &ref))
+	assert.Equal(t, "falcon9", ref.ID)
+	assert.Nil(t, ref.Value)
+	data, err := json.Marshal(ref)
+	require.NoError(t, err)
+	assert.JSONEq(t, `"falcon9"`, string(data))
+
+	ref = Ref[Rocket]{}
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"falcon9","name":"Falcon 9"}`), &ref))
+	assert.Equal(t, "falcon9", ref.ID)
+	require.NotNil(t, ref.Value)
+	assert.Equal(t, "Falcon 9", ref.Value.Name)
+	data, err = json.Marshal(ref)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"name":"Falcon 9"`)
+
+	ref = Ref[Rocket]{}
+	require.NoError(t, json.Unmarshal([]byte(`null`), &ref))
+	assert.Equal(t, Ref[Rocket]{}, ref)
+	data, err = json.Marshal(ref)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+}
+
+func TestSpaceXClient_GetLaunch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/launches/5eb87d46ffd86e000604b388", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(launchJSON(`"5e9d0d95eda69973a809d1ec"`, `"5e9e4502f509094188566f88"`, `["5eb0e4d0b6c3bb0006eeb253"]`, `["5ebf1a6e23a9a60006e03a7a"]`)))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	launch, err := client.GetLaunch(context.Background(), "5eb87d46ffd86e000604b388", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Crew-1", launch.MissionName)
+	assert.Equal(t, "hour", launch.DatePrecision)
+	require.NotNil(t, launch.Success)
+	assert.True(t, *launch.Success)
+	assert.Equal(t, "bnChQbxLkkI", launch.Links.YouTubeID)
+	assert.Equal(t, "https://example.com/small.png", launch.Links.Patch.Small)
+	assert.Empty(t, launch.Links.Presskit)
+	require.Len(t, launch.Cores, 1)
+	assert.Equal(t, "ASDS", launch.Cores[0].LandingType)
+	assert.True(t, *launch.Cores[0].LandingSuccess)
+	assert.Equal(t, "5e9d0d95eda69973a809d1ec", launch.Rocket.ID)
+	assert.Nil(t, launch.Rocket.Value)
+	assert.Equal(t, "5e9e4502f509094188566f88", launch.Launchpad.ID)
+	require.Len(t, launch.Payloads, 1)
+	assert.Equal(t, "5eb0e4d0b6c3bb0006eeb253", launch.Payloads[0].ID)
+	require.Len(t, launch.Crew, 1)
+	assert.Nil(t, launch.Crew[0].Value)
+}
+
+func TestSpaceXClient_GetLaunch_Populate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/launches/query", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"query": map[string]any{"_id": "5eb87d46ffd86e000604b388"},
+			"options": map[string]any{
+				"page":     float64(1),
+				"limit":    float64(1),
+				"populate": []any{"rocket", "launchpad", "payloads", "crew"},
+			},
+		}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"docs":[` + launchJSON(
+			`{"id":"5e9d0d95eda69973a809d1ec","name":"Falcon 9"}`,
+			`{"id":"5e9e4502f509094188566f88","name":"KSC LC 39A","latitude":28.6080585,"longitude":-80.6039558}`,
+			`[{"id":"5eb0e4d0b6c3bb0006eeb253","name":"Crew-1","type":"Crew Dragon","customers":["NASA (CCP)"],"orbit":"ISS","mass_kg":null}]`,
+			`[{"id":"5ebf1a6e23a9a60006e03a7a","name":"Michael Hopkins","agency":"NASA"}]`,
+		) + `],"totalDocs":1,"limit":1,"page":1,"totalPages":1}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	launch, err := client.GetLaunch(context.Background(), "5eb87d46ffd86e000604b388", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, "5e9d0d95eda69973a809d1ec", launch.Rocket.ID)
+	require.NotNil(t, launch.Rocket.Value)
+	assert.Equal(t, "Falcon 9", launch.Rocket.Value.Name)
+	require.NotNil(t, launch.Launchpad.Value)
+	assert.Equal(t, 28.6080585, launch.Launchpad.Value.Latitude)
+	require.Len(t, launch.Payloads, 1)
+	assert.Equal(t, "ISS", launch.Payloads[0].Value.Orbit)
+	assert.Equal(t, []string{"NASA (CCP)"}, launch.Payloads[0].Value.Customers)
+	require.Len(t, launch.Crew, 1)
+	assert.Equal(t, "5ebf1a6e23a9a60006e03a7a", launch.Crew[0].ID)
+	assert.Equal(t, "Michael Hopkins", launch.Crew[0].Value.Name)
+}
+
+func TestSpaceXClient_GetLaunch_PopulateNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"docs":[],"totalDocs":0,"limit":1,"page":1,"totalPages":1}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	launch, err := client.GetLaunch(context.Background(), "unknown", true)
+
+	assert.ErrorIs(t, err, upstream.ErrNotFound)
+	assert.Nil(t, launch)
+}
@@ -0,0 +1,242 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultLaunchPageSize is the number of launches per page when no limit
+	// is given
+	DefaultLaunchPageSize = 10
+	// MaxLaunchPageSize is the largest number of launches per page
+	MaxLaunchPageSize = 100
+)
+
+// LaunchQuery filters, orders and pages the launches listed by ListLaunches.
+// The zero value lists the first page of all launches, newest first.
+type LaunchQuery struct {
+	// Page is the 1-based page number; 0 means the first page
+	Page int
+	// Limit is the number of launches per page; 0 means DefaultLaunchPageSize
+	Limit int
+	// From and To bound the launch date, inclusive; zero values leave that
+	// end of the range open
+	From, To time.Time
+	// Success, when set, only lists launches that did or did not succeed
+	Success *bool
+	// Upcoming, when set, only lists upcoming or past launches
+	Upcoming *bool
+	// RocketID, when set, only lists launches of that rocket
+	RocketID string
+	// Ascending lists the oldest launches first
+	Ascending bool
+}
+
+// LaunchPage is one page of launches
+type LaunchPage struct {
+	Launches      []Launch `json:"launches"`
+	Page          int      `json:"page"`
+	Limit         int      `json:"limit"`
+	TotalLaunches int      `json:"total_launches"`
+	TotalPages    int      `json:"total_pages"`
+	HasNextPage   bool     `json:"has_next_page"`
+}
+
+// Validate checks the query, treating zero Page and Limit as their defaults
+func (q LaunchQuery) Validate() error {
+	if q.Page < 0 {
+		return fmt.Errorf("page must be at least 1, got %d", q.Page)
+	}
+	if q.Limit < 0 || q.Limit > MaxLaunchPageSize {
+		return fmt.Errorf("limit must be between 1 and %d, got %d", MaxLaunchPageSize, q.Limit)
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && q.To.Before(q.From) {
+		return fmt.Errorf("to must not be before from")
+	}
+	return nil
+}
+
+// Key identifies the query: equal queries have the same key, e.g. for caching
+func (q LaunchQuery) Key() string {
+	return fmt.Sprintf("%d:%d:%s:%s:%s:%s:%s:%t",
+		max(q.Page, 1), q.limit(), formatBound(q.From), formatBound(q.To),
+		formatFilter(q.Success), formatFilter(q.Upcoming), q.RocketID, q.Ascending)
+}
+
+func (q LaunchQuery) limit() int {
+	if q.Limit == 0 {
+		return DefaultLaunchPageSize
+	}
+	return q.Limit
+}
+
+func formatBound(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func formatFilter(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
+
+// ParseLaunchTime parses a launch date bound given as RFC 3339 or as
+// YYYY-MM-DD. A date without a time covers the whole day, so as the upper
+// bound of a range (endOfDay) it means the last instant of that day.
+func ParseLaunchTime(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither RFC 3339 nor YYYY-MM-DD", value)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return t, nil
+}
+
+// ParseLaunchQuery reads a launch query from URL parameters: page, limit,
+// from, to, success, upcoming, rocket and sort (asc or desc)
+func ParseLaunchQuery(values url.Values) (LaunchQuery, error) {
+	var q LaunchQuery
+	var err error
+	for _, param := range []struct {
+		name string
+		dst  *int
+	}{{"page", &q.Page}, {"limit", &q.Limit}} {
+		if v := values.Get(param.name); v != "" {
+			if *param.dst, err = strconv.Atoi(v); err != nil || *param.dst < 1 {
+				return q, fmt.Errorf("%s must be a positive number, got %q", param.name, v)
+			}
+		}
+	}
+	if v := values.Get("from"); v != "" {
+		if q.From, err = ParseLaunchTime(v, false); err != nil {
+			return q, fmt.Errorf("from: %w", err)
+		}
+	}
+	if v := values.Get("to"); v != "" {
+		if q.To, err = ParseLaunchTime(v, true); err != nil {
+			return q, fmt.Errorf("to: %w", err)
+		}
+	}
+	for _, param := range []struct {
+		name string
+		dst  **bool
+	}{{"success", &q.Success}, {"upcoming", &q.Upcoming}} {
+		if v := values.Get(param.name); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return q, fmt.Errorf("%s must be true or false, got %q", param.name, v)
+			}
+			*param.dst = &b
+		}
+	}
+	q.RocketID = values.Get("rocket")
+	switch sort := values.Get("sort"); sort {
+	case "", "desc":
+	case "asc":
+		q.Ascending = true
+	default:
+		return q, fmt.Errorf("sort must be asc or desc, got %q", sort)
+	}
+	return q, q.Validate()
+}
+
+// queryRequest is the body of SpaceX's POST /<collection>/query endpoints, a
+// mongoose-paginate query and its options
+type queryRequest struct {
+	Query   map[string]any `json:"query"`
+	Options queryOptions   `json:"options"`
+}
+
+type queryOptions struct {
+	Page  int               `json:"page"`
+	Limit int               `json:"limit"`
+	Sort  map[string]string `json:"sort,omitempty"`
+	// Populate replaces the IDs in these fields with the documents they
+	// reference
+	Populate []string `json:"populate,omitempty"`
+}
+
+// queryResponse is a page of results of SpaceX's POST /<collection>/query
+type queryResponse[T any] struct {
+	Docs        []T  `json:"docs"`
+	TotalDocs   int  `json:"totalDocs"`
+	Limit       int  `json:"limit"`
+	Page        int  `json:"page"`
+	TotalPages  int  `json:"totalPages"`
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// request translates the query into the body of POST /launches/query
+func (q LaunchQuery) request() queryRequest {
+	filter := map[string]any{}
+	dateRange := map[string]string{}
+	if !q.From.IsZero() {
+		dateRange["$gte"] = formatBound(q.From)
+	}
+	if !q.To.IsZero() {
+		dateRange["$lte"] = formatBound(q.To)
+	}
+	if len(dateRange) > 0 {
+		filter["date_utc"] = dateRange
+	}
+	if q.Success != nil {
+		filter["success"] = *q.Success
+	}
+	if q.Upcoming != nil {
+		filter["upcoming"] = *q.Upcoming
+	}
+	if q.RocketID != "" {
+		filter["rocket"] = q.RocketID
+	}
+
+	order := "desc"
+	if q.Ascending {
+		order = "asc"
+	}
+	return queryRequest{
+		Query: filter,
+		Options: queryOptions{
+			Page:  max(q.Page, 1),
+			Limit: q.limit(),
+			Sort:  map[string]string{"date_utc": order},
+		},
+	}
+}
+
+// ListLaunches fetches one page of the launches matching query
+func (c *SpaceXClient) ListLaunches(ctx context.Context, query LaunchQuery) (*LaunchPage, error) {
+	if err := query.Validate(); err != nil {
+		return nil, err
+	}
+
+	var resp queryResponse[Launch]
+	if err := postQuery(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/launches/query", c.baseURL), query.request(), &resp); err != nil {
+		return nil, err
+	}
+
+	launches := resp.Docs
+	if launches == nil {
+		launches = []Launch{}
+	}
+	return &LaunchPage{
+		Launches:      launches,
+		Page:          resp.Page,
+		Limit:         resp.Limit,
+		TotalLaunches: resp.TotalDocs,
+		TotalPages:    resp.TotalPages,
+		HasNextPage:   resp.HasNextPage,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLaunchQuery(t *testing.T) {
+	query, err := ParseLaunchQuery(url.Values{})
+	require.NoError(t, err)
+	assert.Equal(t, LaunchQuery{}, query)
+
+	query, err = ParseLaunchQuery(url.Values{
+		"page":     {"2"},
+		"limit":    {"25"},
+		"from":     {"2020-01-01"},
+		"to":       {"2020-12-31"},
+		"success":  {"true"},
+		"upcoming": {"false"},
+		"rocket":   {"falcon9"},
+		"sort":     {"asc"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, query.Page)
+	assert.Equal(t, 25, query.Limit)
+	assert.Equal(t, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), query.From)
+	// A date without a time covers the whole day as the upper bound
+	assert.Equal(t, time.Date(2020, 12, 31, 23, 59, 59, 999999999, time.UTC), query.To)
+	require.NotNil(t, query.Success)
+	assert.True(t, *query.Success)
+	require.NotNil(t, query.Upcoming)
+	assert.False(t, *query.Upcoming)
+	assert.Equal(t, "falcon9", query.RocketID)
+	assert.True(t, query.Ascending)
+
+	query, err = ParseLaunchQuery(url.Values{"from": {"2020-06-01T12:00:00+02:00"}})
+	require.NoError(t, err)
+	assert.True(t, time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC).Equal(query.From))
+}
+
+func TestParseLaunchQuery_Invalid(t *testing.T) {
+	for _, values := range []url.Values{
+		{"page": {"0"}},
+		{"page": {"one"}},
+		{"limit": {"-1"}},
+		{"limit": {"101"}},
+		{"from": {"yesterday"}},
+		{"to": {"2020-13-01"}},
+		{"from": {"2021-01-01"}, "to": {"2020-01-01"}},
+		{"success": {"maybe"}},
+		{"upcoming": {"soon"}},
+		{"sort": {"random"}},
+	} {
+		_, err := ParseLaunchQuery(values)
+		assert.Error(t, err, values.Encode())
+	}
+}
+
+func TestLaunchQuery_Key(t *testing.T) {
+	success := true
+	// Defaults and explicit values of the same query share a key
+	assert.Equal(t, LaunchQuery{}.Key(), LaunchQuery{Page: 1, Limit: DefaultLaunchPageSize}.Key())
+	assert.NotEqual(t, LaunchQuery{}.Key(), LaunchQuery{Page: 2}.Key())
+	assert.NotEqual(t, LaunchQuery{}.Key(), LaunchQuery{Success: &success}.Key())
+	assert.NotEqual(t, LaunchQuery{}.Key(), LaunchQuery{Ascending: true}.Key())
+}
+
+func TestSpaceXClient_ListLaunches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/launches/query", r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"query": map[string]any{
+				"date_utc": map[string]any{"$gte": "2020-01-01T00:00:00Z"},
+				"success":  true,
+				"rocket":   "falcon9",
+			},
+			"options": map[string]any{
+				"page":  float64(2),
+				"limit": float64(1),
+				"sort":  map[string]any{"date_utc": "asc"},
+			},
+		}, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"docs":[{"id":"abc","flight_number":10,"name":"CRS-1","rocket":"falcon9","upcoming":false,"success":true}],"totalDocs":3,"limit":1,"page":2,"totalPages":3,"hasNextPage":true}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	success := true
+	page, err := client.ListLaunches(context.Background(), LaunchQuery{
+		Page:      2,
+		Limit:     1,
+		From:      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Success:   &success,
+		RocketID:  "falcon9",
+		Ascending: true,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, page.Launches, 1)
+	assert.Equal(t, "abc", page.Launches[0].ID)
+	assert.Equal(t, "CRS-1", page.Launches[0].MissionName)
+	assert.Equal(t, "falcon9", page.Launches[0].RocketID)
+	assert.Equal(t, 2, page.Page)
+	assert.Equal(t, 1, page.Limit)
+	assert.Equal(t, 3, page.TotalLaunches)
+	assert.Equal(t, 3, page.TotalPages)
+	assert.True(t, page.HasNextPage)
+}
+
+func TestSpaceXClient_ListLaunches_Empty(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"docs":[],"totalDocs":0,"limit":10,"page":1,"totalPages":1,"hasNextPage":false}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	page, err := client.ListLaunches(context.Background(), LaunchQuery{})
+
+	require.NoError(t, err)
+	assert.NotNil(t, page.Launches)
+	assert.Empty(t, page.Launches)
+	assert.False(t, page.HasNextPage)
+}
+
+func TestSpaceXClient_ListLaunches_RetriesTransientFailure(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"docs":[],"page":1,"limit":10}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(
+		WithBaseURL(server.URL+"/v4"),
+		WithRetryPolicy(upstream.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+
+	_, err := client.ListLaunches(context.Background(), LaunchQuery{})
+
+	// The query only reads, so it is retried with the same body
+	assert.NoError(t, err)
+	require.Len(t, bodies, 2)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.NotEmpty(t, bodies[1])
+}
+
+func TestSpaceXClient_ListLaunches_InvalidQuery(t *testing.T) {
+	client := NewSpaceXClient(WithBaseURL("http://127.0.0.1:0"))
+
+	page, err := client.ListLaunches(context.Background(), LaunchQuery{Limit: MaxLaunchPageSize + 1})
+
+	assert.Error(t, err)
+	assert.Nil(t, page)
+}
@@ -13,6 +13,16 @@ func Init() {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stdout, TimeFormat: time.RFC3339})
 }
 
+// SetLevel sets the global minimum log level from its name (debug, info, warn, ...)
+func SetLevel(level string) error {
+	lvl, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	zerolog.SetGlobalLevel(lvl)
+	return nil
+}
+
 // GetLogger returns the global logger instance
 func GetLogger() zerolog.Logger {
 	return log.Logger
@@ -27,3 +27,14 @@ func TestGetLogger(t *testing.T) {
 	// Basic check that we get a valid logger
 	assert.NotNil(t, logger)
 }
+
+func TestSetLevel(t *testing.T) {
+	origLevel := zerolog.GlobalLevel()
+	defer zerolog.SetGlobalLevel(origLevel)
+
+	assert.NoError(t, SetLevel("warn"))
+	assert.Equal(t, zerolog.WarnLevel, zerolog.GlobalLevel())
+
+	assert.Error(t, SetLevel("loud"))
+	assert.Equal(t, zerolog.WarnLevel, zerolog.GlobalLevel())
+}
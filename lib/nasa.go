@@ -1,11 +1,17 @@
 package lib
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
+	"outerspace-go/lib/requestid"
+	"outerspace-go/lib/upstream"
+
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
@@ -13,7 +19,9 @@ import (
 type NASAClient struct {
 	baseURL    string
 	httpClient *http.Client
-	apiKey     string
+	apiKey     func() (string, error)
+	// keyErrors limits how often a failing API key source is logged
+	keyErrors *zerolog.BurstSampler
 }
 
 // Response structures for NASA API
@@ -26,87 +34,90 @@ type APOD struct {
 	ServiceVersion string `json:"service_version"`
 }
 
+// DemoAPIKey is NASA's shared, heavily rate-limited key used when no key is configured
+const DemoAPIKey = "DEMO_KEY"
+
 // NewNASAClient creates a new NASA API client
-func NewNASAClient() *NASAClient {
+func NewNASAClient(opts ...ClientOption) *NASAClient {
+	o := newClientOptions(DefaultNASABaseURL, opts)
+	apiKey := o.apiKey
+	if apiKey == nil {
+		apiKey = func() (string, error) {
+			return DemoAPIKey, nil
+		}
+	}
 	return &NASAClient{
-		baseURL: "https://api.nasa.gov",
-		httpClient: &http.Client{
-			Timeout: time.Second * 10,
-		},
-		apiKey: "DEMO_KEY", // Using demo key for simplicity
+		baseURL:    o.baseURL,
+		httpClient: o.client(NASAUpstream),
+		apiKey:     apiKey,
+		keyErrors:  &zerolog.BurstSampler{Burst: 1, Period: time.Minute},
 	}
 }
 
-// Add logging to API calls
-func (c *NASAClient) makeRequest(method, url string) (*http.Response, error) {
-	start := time.Now()
-
-	req, err := http.NewRequest(method, url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	duration := time.Since(start)
-
-	if err != nil {
-		log.Error().
-			Str("method", method).
-			Str("host", req.URL.Host).
-			Dur("latency", duration).
-			Err(err).
-			Msg("API request failed")
-		return nil, err
+// redactAPIKey strips the api_key query parameter from the URL that
+// *url.Error embeds in its message so the key never ends up in logs
+func redactAPIKey(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
 	}
-
-	// Log X-prefixed headers
-	for header, values := range resp.Header {
-		if len(header) > 0 && (header[0] == 'X' || header[0] == 'x') {
-			log.Info().
-				Str("header", header).
-				Strs("values", values).
-				Msg("X-Header found")
-		}
+	if u, parseErr := url.Parse(urlErr.URL); parseErr == nil && u.Query().Has("api_key") {
+		q := u.Query()
+		q.Set("api_key", "REDACTED")
+		u.RawQuery = q.Encode()
+		urlErr.URL = u.String()
 	}
+	return err
+}
 
-	log.Info().
-		Str("method", method).
-		Str("host", req.URL.Host).
-		Int("status", resp.StatusCode).
-		Dur("latency", duration).
-		Msg("API request completed")
+// APODDateLayout is the format of APOD dates, YYYY-MM-DD
+const APODDateLayout = "2006-01-02"
 
-	return resp, nil
+// ValidAPODDate reports whether date is empty or a valid YYYY-MM-DD date
+func ValidAPODDate(date string) bool {
+	if date == "" {
+		return true
+	}
+	_, err := time.Parse(APODDateLayout, date)
+	return err == nil
 }
 
-// GetAPOD fetches the Astronomy Picture of the Day
-func (c *NASAClient) GetAPOD() (*APOD, error) {
-	resp, err := c.makeRequest("GET", fmt.Sprintf("%s/planetary/apod?api_key=%s", c.baseURL, c.apiKey))
+// GetAPOD fetches the Astronomy Picture of the Day for date (YYYY-MM-DD), or
+// for today when date is empty
+func (c *NASAClient) GetAPOD(ctx context.Context, date string) (*APOD, error) {
+	// A key source that fails while rotating, e.g. a secret file rewritten
+	// in place, still hands out the last good key; only fail without one
+	apiKey, err := c.apiKey()
 	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	// Check for rate limiting headers
-	if remaining := resp.Header.Get("X-RateLimit-Remaining"); remaining != "" {
-		if remaining == "0" {
-			return nil, fmt.Errorf("NASA API rate limit exceeded")
+		if apiKey == "" {
+			return nil, fmt.Errorf("loading NASA API key: %w", err)
 		}
+		logger := log.Sample(c.keyErrors)
+		logger.Warn().Err(err).Msg("Using last good NASA API key")
 	}
-	
-	// Also check for 429 status code (Too Many Requests)
-	if resp.StatusCode == http.StatusTooManyRequests {
-		return nil, fmt.Errorf("NASA API rate limit exceeded (429)")
+
+	query := url.Values{"api_key": {apiKey}}
+	if date != "" {
+		query.Set("date", date)
 	}
-	
-	// Check for other non-success status codes
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("NASA API error: HTTP %d", resp.StatusCode)
+	resp, err := get(ctx, c.httpClient, fmt.Sprintf("%s/planetary/apod?%s", c.baseURL, query.Encode()))
+	if err != nil {
+		return nil, upstream.Classify(NASAUpstream, redactAPIKey(err))
 	}
+	defer resp.Body.Close()
 
+	// Only a 429 means rate limited, see decodeJSON
 	var apod APOD
-	if err := json.NewDecoder(resp.Body).Decode(&apod); err != nil {
+	if err := decodeJSON(NASAUpstream, resp, &apod); err != nil {
 		return nil, err
 	}
+	// The last request the key allows still succeeded, but the next ones
+	// will be refused until the limit resets
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		log.Warn().
+			Str("request_id", requestid.FromContext(ctx)).
+			Str("upstream", NASAUpstream).
+			Msg("NASA API rate limit used up")
+	}
 	return &apod, nil
 }
\ No newline at end of file
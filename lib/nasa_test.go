@@ -0,0 +1,198 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"outerspace-go/lib/secret"
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNASAClient_GetAPOD(t *testing.T) {
+	// Setup test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/planetary/apod", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "test-key", r.URL.Query().Get("api_key"))
+
+		// Return a sample response
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"title":"Pillars of Creation","date":"2024-01-01","explanation":"Stars forming","url":"https://apod.nasa.gov/image.jpg","media_type":"image"}`))
+	}))
+	defer server.Close()
+
+	// Create client with test server URL
+	client := NewNASAClient(WithBaseURL(server.URL), WithAPIKey("test-key"))
+
+	// Call the method
+	apod, err := client.GetAPOD(context.Background(), "")
+
+	// Assert results
+	assert.NoError(t, err)
+	assert.Equal(t, "Pillars of Creation", apod.Title)
+	assert.Equal(t, "2024-01-01", apod.Date)
+	assert.Equal(t, "image", apod.MediaType)
+}
+
+func TestNASAClient_GetAPOD_DefaultsToDemoKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, DemoAPIKey, r.URL.Query().Get("api_key"))
+		w.Write([]byte(`{"title":"Demo"}`))
+	}))
+	defer server.Close()
+
+	client := NewNASAClient(WithBaseURL(server.URL))
+
+	apod, err := client.GetAPOD(context.Background(), "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Demo", apod.Title)
+}
+
+func TestNASAClient_GetAPOD_RateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewNASAClient(WithBaseURL(server.URL))
+
+	apod, err := client.GetAPOD(context.Background(), "")
+
+	assert.ErrorIs(t, err, upstream.ErrRateLimited)
+	assert.Nil(t, apod)
+}
+
+func TestNASAClient_GetAPOD_RateLimitHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Write([]byte(`{"title":"Just in time"}`))
+	}))
+	defer server.Close()
+
+	client := NewNASAClient(WithBaseURL(server.URL))
+
+	apod, err := client.GetAPOD(context.Background(), "")
+
+	// The request that used up the limit still succeeded
+	require.NoError(t, err)
+	assert.Equal(t, "Just in time", apod.Title)
+}
+
+func TestNASAClient_GetAPOD_RotatedKey(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.URL.Query().Get("api_key"))
+		w.Write([]byte(`{"title":"Rotated"}`))
+	}))
+	defer server.Close()
+
+	key := "first"
+	client := NewNASAClient(WithBaseURL(server.URL), WithAPIKeyFunc(func() (string, error) {
+		return key, nil
+	}))
+
+	_, err := client.GetAPOD(context.Background(), "")
+	assert.NoError(t, err)
+	key = "second"
+	_, err = client.GetAPOD(context.Background(), "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"first", "second"}, seen)
+}
+
+func TestNASAClient_GetAPOD_KeyError(t *testing.T) {
+	client := NewNASAClient(WithAPIKeyFunc(func() (string, error) {
+		return "", errors.New("secret file missing")
+	}))
+
+	apod, err := client.GetAPOD(context.Background(), "")
+
+	assert.ErrorContains(t, err, "secret file missing")
+	assert.Nil(t, apod)
+}
+
+func TestNASAClient_GetAPOD_ErrorDoesNotLeakKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	// A closed server makes the request fail at the transport level
+	server.Close()
+
+	client := NewNASAClient(WithBaseURL(server.URL), WithAPIKey("top-secret-key"))
+
+	_, err := client.GetAPOD(context.Background(), "")
+
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "top-secret-key")
+	assert.Contains(t, err.Error(), "REDACTED")
+}
+
+func TestNASAClient_GetAPOD_Date(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "2024-04-08", r.URL.Query().Get("date"))
+		w.Write([]byte(`{"title":"Eclipse","date":"2024-04-08"}`))
+	}))
+	defer server.Close()
+
+	client := NewNASAClient(WithBaseURL(server.URL))
+
+	apod, err := client.GetAPOD(context.Background(), "2024-04-08")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-04-08", apod.Date)
+}
+
+func TestValidAPODDate(t *testing.T) {
+	assert.True(t, ValidAPODDate(""))
+	assert.True(t, ValidAPODDate("2024-04-08"))
+	assert.False(t, ValidAPODDate("2024-4-8"))
+	assert.False(t, ValidAPODDate("2024-02-30"))
+	assert.False(t, ValidAPODDate("today"))
+}
+
+func TestNASAClient_GetAPOD_KeyFileRotation(t *testing.T) {
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.URL.Query().Get("api_key"))
+		w.Write([]byte(`{"title":"Rotated"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "nasa-api-key")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+	keyFile, err := secret.NewFile(path)
+	require.NoError(t, err)
+
+	client := NewNASAClient(WithBaseURL(server.URL), WithAPIKeyFunc(keyFile.Value))
+
+	// rewrite replaces the key file in place, moving its modification time
+	// on even on filesystems with coarse timestamps
+	rewrite := func(content string, age time.Duration) {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		mtime := time.Now().Add(age)
+		require.NoError(t, os.Chtimes(path, mtime, mtime))
+	}
+
+	_, err = client.GetAPOD(context.Background(), "")
+	assert.NoError(t, err)
+
+	// A non-atomic rewrite leaves the file briefly empty
+	rewrite("", time.Minute)
+	_, err = client.GetAPOD(context.Background(), "")
+	assert.NoError(t, err)
+
+	rewrite("second\n", 2*time.Minute)
+	_, err = client.GetAPOD(context.Background(), "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"first", "first", "second"}, seen)
+}
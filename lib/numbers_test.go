@@ -1,9 +1,13 @@
 package lib
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+
+	"outerspace-go/lib/upstream"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -23,11 +27,10 @@ func TestNumbersClient_GetMathFact(t *testing.T) {
 	defer server.Close()
 
 	// Create client with test server URL
-	client := NewNumbersClient()
-	client.baseURL = server.URL
+	client := NewNumbersClient(WithBaseURL(server.URL))
 
 	// Call the method
-	fact, err := client.GetMathFact()
+	fact, err := client.GetMathFact(context.Background())
 
 	// Assert results
 	assert.NoError(t, err)
@@ -36,3 +39,47 @@ func TestNumbersClient_GetMathFact(t *testing.T) {
 	assert.True(t, fact.Found)
 	assert.Equal(t, "math", fact.Type)
 }
+
+func TestNumbersClient_GetMathFact_CircuitOpen(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	breaker := upstream.NewBreaker("numbers", upstream.BreakerSettings{
+		Window:      time.Minute,
+		MinRequests: 1,
+		FailureRate: 0.5,
+		CoolDown:    time.Minute,
+	})
+	client := NewNumbersClient(
+		WithBaseURL(server.URL),
+		WithRetryPolicy(upstream.RetryPolicy{MaxAttempts: 1}),
+		WithCircuitBreaker(breaker),
+	)
+
+	// The first failure trips the breaker, the second call never reaches the server
+	client.GetMathFact(context.Background())
+	_, err := client.GetMathFact(context.Background())
+
+	assert.ErrorIs(t, err, upstream.ErrCircuitOpen)
+	assert.ErrorIs(t, err, upstream.ErrUnavailable)
+	assert.Equal(t, 1, calls)
+}
+
+func TestNumbersClient_GetMathFact_Unavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewNumbersClient(WithBaseURL(server.URL), WithRetryPolicy(upstream.RetryPolicy{MaxAttempts: 1}))
+
+	fact, err := client.GetMathFact(context.Background())
+
+	assert.ErrorIs(t, err, upstream.ErrUnavailable)
+	assert.True(t, err.(*upstream.Error).Retryable())
+	assert.Nil(t, fact)
+}
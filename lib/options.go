@@ -0,0 +1,150 @@
+package lib
+
+import (
+	"net/http"
+	"time"
+
+	"outerspace-go/lib/upstream"
+)
+
+// Default upstream base URLs used when no override is configured
+const (
+	DefaultSpaceXBaseURL  = "https://api.spacexdata.com/v4"
+	DefaultNumbersBaseURL = "http://numbersapi.com"
+	DefaultNASABaseURL    = "https://api.nasa.gov"
+)
+
+// Upstream names used in logs, errors and circuit breaker status
+const (
+	SpaceXUpstream  = "spacex"
+	NumbersUpstream = "numbers"
+	NASAUpstream    = "nasa"
+)
+
+// DefaultTimeout is the default overall timeout for a single upstream request
+const DefaultTimeout = 10 * time.Second
+
+// DefaultConditionalEntries is how many responses each client keeps by
+// default for conditional revalidation with ETag / Last-Modified
+const DefaultConditionalEntries = 256
+
+// ClientOption configures an upstream API client
+type ClientOption func(*clientOptions)
+
+// clientOptions holds the settings shared by the upstream API clients
+type clientOptions struct {
+	baseURL    string
+	httpClient *http.Client
+	// timeout is zero unless set explicitly through WithTimeout
+	timeout     time.Duration
+	apiKey      func() (string, error)
+	retryPolicy upstream.RetryPolicy
+	breaker     *upstream.Breaker
+	// conditionalEntries bounds the validator store; zero disables it
+	conditionalEntries int
+}
+
+// newClientOptions returns the client options with defaults applied first
+func newClientOptions(baseURL string, opts []ClientOption) clientOptions {
+	o := clientOptions{
+		baseURL:            baseURL,
+		retryPolicy:        upstream.DefaultRetryPolicy(),
+		conditionalEntries: DefaultConditionalEntries,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// client returns the instrumented HTTP client to use for calls to the named
+// upstream. Every attempt is logged, GET responses carrying ETag or
+// Last-Modified are revalidated with conditional requests, idempotent
+// requests are retried according to the retry policy, and a configured
+// circuit breaker sees each request once, after its retries. The timeout is
+// enforced by an upstream.TimeoutTransport below the breaker rather than by
+// http.Client.Timeout, so that the breaker counts an upstream that hangs as
+// failing. A client passed through WithHTTPClient is copied rather than
+// modified; its Timeout applies unless WithTimeout was given.
+func (o clientOptions) client(name string) *http.Client {
+	httpClient := o.httpClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultTimeout}
+	}
+	timeout := httpClient.Timeout
+	if o.timeout != 0 {
+		timeout = o.timeout
+	}
+	c := upstream.Instrument(name, httpClient)
+	c.Timeout = 0
+	if o.conditionalEntries > 0 {
+		c.Transport = upstream.NewConditionalTransport(c.Transport, o.conditionalEntries)
+	}
+	c.Transport = upstream.NewRetryTransport(name, c.Transport, o.retryPolicy)
+	c.Transport = upstream.NewTimeoutTransport(c.Transport, timeout)
+	if o.breaker != nil {
+		c.Transport = upstream.NewBreakerTransport(o.breaker, c.Transport)
+	}
+	return c
+}
+
+// WithBaseURL overrides the upstream base URL, e.g. to point at a mirror or mock server
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *clientOptions) {
+		o.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for upstream calls
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *clientOptions) {
+		o.httpClient = httpClient
+	}
+}
+
+// WithTimeout sets the overall timeout for each upstream request, covering
+// all of its retries
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetryPolicy sets how failed idempotent upstream requests are retried.
+// Use a policy with MaxAttempts of 1 to disable retries.
+func WithRetryPolicy(policy upstream.RetryPolicy) ClientOption {
+	return func(o *clientOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithCircuitBreaker sends every upstream request through the breaker so that
+// calls fail fast with upstream.ErrCircuitOpen while the upstream is down
+func WithCircuitBreaker(breaker *upstream.Breaker) ClientOption {
+	return func(o *clientOptions) {
+		o.breaker = breaker
+	}
+}
+
+// WithConditionalRequests sets how many responses are kept for revalidation
+// with If-None-Match / If-Modified-Since. Zero disables conditional requests.
+func WithConditionalRequests(maxEntries int) ClientOption {
+	return func(o *clientOptions) {
+		o.conditionalEntries = maxEntries
+	}
+}
+
+// WithAPIKey sets the API key sent to upstreams that require one (NASA)
+func WithAPIKey(apiKey string) ClientOption {
+	return WithAPIKeyFunc(func() (string, error) {
+		return apiKey, nil
+	})
+}
+
+// WithAPIKeyFunc sets a function that is asked for the API key on every
+// request, allowing keys to be rotated while the client is running
+func WithAPIKeyFunc(apiKey func() (string, error)) ClientOption {
+	return func(o *clientOptions) {
+		o.apiKey = apiKey
+	}
+}
@@ -0,0 +1,106 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// timeoutTransport finds the upstream.TimeoutTransport in a client's chain
+func timeoutTransport(t *testing.T, c *http.Client) *upstream.TimeoutTransport {
+	transport := c.Transport
+	if breaker, ok := transport.(*upstream.BreakerTransport); ok {
+		transport = breaker.Base
+	}
+	timeout, ok := transport.(*upstream.TimeoutTransport)
+	require.True(t, ok, "no timeout transport in %T", c.Transport)
+	// http.Client.Timeout would hide upstream timeouts from the breaker
+	assert.Zero(t, c.Timeout)
+	return timeout
+}
+
+func TestClientOptions_Defaults(t *testing.T) {
+	client := NewSpaceXClient()
+
+	assert.Equal(t, DefaultSpaceXBaseURL, client.baseURL)
+	assert.Equal(t, DefaultTimeout, timeoutTransport(t, client.httpClient).Timeout)
+}
+
+func TestClientOptions_WithTimeout(t *testing.T) {
+	client := NewNumbersClient(WithTimeout(2 * time.Second))
+
+	assert.Equal(t, DefaultNumbersBaseURL, client.baseURL)
+	assert.Equal(t, 2*time.Second, timeoutTransport(t, client.httpClient).Timeout)
+}
+
+func TestClientOptions_WithHTTPClient(t *testing.T) {
+	httpClient := &http.Client{Timeout: time.Minute}
+
+	// The caller's client is copied and instrumented, never modified
+	client := NewNASAClient(WithHTTPClient(httpClient))
+	assert.NotSame(t, httpClient, client.httpClient)
+	assert.Equal(t, time.Minute, timeoutTransport(t, client.httpClient).Timeout)
+	assert.IsType(t, &upstream.RetryTransport{}, timeoutTransport(t, client.httpClient).Base)
+	assert.Nil(t, httpClient.Transport)
+
+	client = NewNASAClient(WithHTTPClient(httpClient), WithTimeout(time.Second))
+	assert.Equal(t, time.Second, timeoutTransport(t, client.httpClient).Timeout)
+	assert.Equal(t, time.Minute, httpClient.Timeout)
+}
+
+func TestClientOptions_WithRetryPolicy(t *testing.T) {
+	policy := upstream.RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: time.Second}
+
+	client := NewSpaceXClient(WithRetryPolicy(policy))
+
+	transport, ok := timeoutTransport(t, client.httpClient).Base.(*upstream.RetryTransport)
+	assert.True(t, ok)
+	assert.Equal(t, policy, transport.Policy)
+	assert.Equal(t, "spacex", transport.Name)
+}
+
+func TestClientOptions_WithConditionalRequests(t *testing.T) {
+	client := NewNumbersClient()
+	transport := timeoutTransport(t, client.httpClient).Base.(*upstream.RetryTransport)
+	assert.IsType(t, &upstream.ConditionalTransport{}, transport.Base)
+
+	client = NewNumbersClient(WithConditionalRequests(0))
+	transport = timeoutTransport(t, client.httpClient).Base.(*upstream.RetryTransport)
+	assert.IsType(t, &upstream.Transport{}, transport.Base)
+}
+
+func TestClientOptions_BreakerOpensOnUpstreamTimeouts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	breaker := upstream.NewBreaker(SpaceXUpstream, upstream.BreakerSettings{
+		Window:      time.Minute,
+		MinRequests: 3,
+		FailureRate: 0.5,
+		CoolDown:    time.Minute,
+	})
+	client := NewSpaceXClient(
+		WithBaseURL(server.URL),
+		WithTimeout(20*time.Millisecond),
+		WithRetryPolicy(upstream.RetryPolicy{MaxAttempts: 1}),
+		WithCircuitBreaker(breaker),
+	)
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetLatestLaunch(context.Background())
+		assert.ErrorIs(t, err, upstream.ErrTimeout)
+	}
+
+	assert.Equal(t, upstream.StateOpen, breaker.State())
+	_, err := client.GetLatestLaunch(context.Background())
+	assert.ErrorIs(t, err, upstream.ErrCircuitOpen)
+}
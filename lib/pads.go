@@ -0,0 +1,149 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// earthRadiusKm is the mean radius of the Earth
+const earthRadiusKm = 6371.0
+
+// Landpad is a landing zone or drone ship SpaceX lands first stages on
+type Landpad struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	// Type is RTLS for landing zones and ASDS for drone ships
+	Type             string  `json:"type"`
+	Locality         string  `json:"locality"`
+	Region           string  `json:"region"`
+	Latitude         float64 `json:"latitude"`
+	Longitude        float64 `json:"longitude"`
+	LandingAttempts  int     `json:"landing_attempts"`
+	LandingSuccesses int     `json:"landing_successes"`
+	// Status is active, inactive, unknown, retired, lost or under construction
+	Status string `json:"status"`
+}
+
+// NearQuery selects the sites around a point, closest first
+type NearQuery struct {
+	Latitude  float64
+	Longitude float64
+	// RadiusKm leaves out sites further away than this, 0 keeps all of them
+	RadiusKm float64
+}
+
+// Validate checks that the point is on the globe and the radius is not
+// negative
+func (q NearQuery) Validate() error {
+	// Written so that NaN fails the checks too
+	if !(q.Latitude >= -90 && q.Latitude <= 90) {
+		return fmt.Errorf("latitude must be between -90 and 90, got %v", q.Latitude)
+	}
+	if !(q.Longitude >= -180 && q.Longitude <= 180) {
+		return fmt.Errorf("longitude must be between -180 and 180, got %v", q.Longitude)
+	}
+	if !(q.RadiusKm >= 0) || math.IsInf(q.RadiusKm, 1) {
+		return fmt.Errorf("radius_km must be a positive number, got %v", q.RadiusKm)
+	}
+	return nil
+}
+
+// ParseNearQuery reads the near=lat,lon and radius_km= query parameters. It
+// returns nil when near is not given.
+func ParseNearQuery(values url.Values) (*NearQuery, error) {
+	near, radius := values.Get("near"), values.Get("radius_km")
+	if near == "" {
+		if radius != "" {
+			return nil, errors.New("radius_km requires near")
+		}
+		return nil, nil
+	}
+
+	var q NearQuery
+	lat, lon, ok := strings.Cut(near, ",")
+	if !ok {
+		return nil, fmt.Errorf("near must be latitude,longitude, got %q", near)
+	}
+	var latErr, lonErr error
+	q.Latitude, latErr = strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	q.Longitude, lonErr = strconv.ParseFloat(strings.TrimSpace(lon), 64)
+	if latErr != nil || lonErr != nil {
+		return nil, fmt.Errorf("near must be latitude,longitude, got %q", near)
+	}
+	if radius != "" {
+		var err error
+		if q.RadiusKm, err = strconv.ParseFloat(radius, 64); err != nil || q.RadiusKm <= 0 {
+			return nil, fmt.Errorf("radius_km must be a positive number, got %q", radius)
+		}
+	}
+	return &q, q.Validate()
+}
+
+// DistanceKm returns the great-circle distance between two points given in
+// degrees, using the haversine formula
+func DistanceKm(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// NearbyLaunchpad is a launchpad with its distance from the point of a
+// NearQuery
+type NearbyLaunchpad struct {
+	Launchpad
+	DistanceKm float64 `json:"distance_km"`
+}
+
+// LaunchpadsNear returns the launchpads within the query's radius, closest
+// first
+func LaunchpadsNear(launchpads []Launchpad, q NearQuery) []NearbyLaunchpad {
+	nearby := make([]NearbyLaunchpad, 0, len(launchpads))
+	for _, launchpad := range launchpads {
+		distance := DistanceKm(q.Latitude, q.Longitude, launchpad.Latitude, launchpad.Longitude)
+		if q.RadiusKm > 0 && distance > q.RadiusKm {
+			continue
+		}
+		nearby = append(nearby, NearbyLaunchpad{Launchpad: launchpad, DistanceKm: distance})
+	}
+	sort.SliceStable(nearby, func(i, j int) bool {
+		return nearby[i].DistanceKm < nearby[j].DistanceKm
+	})
+	return nearby
+}
+
+// GetAllLaunchpads fetches all SpaceX launchpads
+func (c *SpaceXClient) GetAllLaunchpads(ctx context.Context) ([]Launchpad, error) {
+	var launchpads []Launchpad
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/launchpads", c.baseURL), &launchpads); err != nil {
+		return nil, err
+	}
+	return launchpads, nil
+}
+
+// GetLaunchpad fetches a specific launchpad by its ID
+func (c *SpaceXClient) GetLaunchpad(ctx context.Context, launchpadID string) (*Launchpad, error) {
+	var launchpad Launchpad
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/launchpads/%s", c.baseURL, url.PathEscape(launchpadID)), &launchpad); err != nil {
+		return nil, err
+	}
+	return &launchpad, nil
+}
+
+// GetAllLandpads fetches all SpaceX landing zones and drone ships
+func (c *SpaceXClient) GetAllLandpads(ctx context.Context) ([]Landpad, error) {
+	var landpads []Landpad
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/landpads", c.baseURL), &landpads); err != nil {
+		return nil, err
+	}
+	return landpads, nil
+}
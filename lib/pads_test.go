@@ -0,0 +1,167 @@
+package lib
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"outerspace-go/lib/upstream"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLaunchpads are SpaceX's Florida, California and Texas launch sites
+var testLaunchpads = []Launchpad{
+	{ID: "vafb", Name: "VAFB SLC 4E", Latitude: 34.632093, Longitude: -120.610829},
+	{ID: "ksc", Name: "KSC LC 39A", Latitude: 28.6080585, Longitude: -80.6039558},
+	{ID: "boca", Name: "STLS", Latitude: 25.9972641, Longitude: -97.1560845},
+	{ID: "ccsfs", Name: "CCSFS SLC 40", Latitude: 28.5618571, Longitude: -80.577366},
+}
+
+func TestDistanceKm(t *testing.T) {
+	assert.Equal(t, 0.0, DistanceKm(28.6, -80.6, 28.6, -80.6))
+	// A quarter of the way around the equator
+	assert.InDelta(t, math.Pi*earthRadiusKm/2, DistanceKm(0, 0, 0, 90), 1e-6)
+	// Pole to pole
+	assert.InDelta(t, math.Pi*earthRadiusKm, DistanceKm(90, 0, -90, 0), 1e-6)
+	// Across the antimeridian
+	assert.InDelta(t, DistanceKm(0, 179, 0, -179), DistanceKm(0, -1, 0, 1), 1e-9)
+	// Los Angeles to New York is about 3,940 km
+	assert.InDelta(t, 3940, DistanceKm(34.0522, -118.2437, 40.7128, -74.0060), 10)
+}
+
+func TestParseNearQuery(t *testing.T) {
+	q, err := ParseNearQuery(url.Values{})
+	require.NoError(t, err)
+	assert.Nil(t, q)
+
+	q, err = ParseNearQuery(url.Values{"near": {"28.5, -80.6"}})
+	require.NoError(t, err)
+	assert.Equal(t, &NearQuery{Latitude: 28.5, Longitude: -80.6}, q)
+
+	q, err = ParseNearQuery(url.Values{"near": {"28.5,-80.6"}, "radius_km": {"100"}})
+	require.NoError(t, err)
+	assert.Equal(t, &NearQuery{Latitude: 28.5, Longitude: -80.6, RadiusKm: 100}, q)
+
+	for _, values := range []url.Values{
+		{"radius_km": {"100"}},
+		{"near": {"28.5"}},
+		{"near": {"north,west"}},
+		{"near": {"91,0"}},
+		{"near": {"0,-181"}},
+		{"near": {"NaN,0"}},
+		{"near": {"0,0"}, "radius_km": {"0"}},
+		{"near": {"0,0"}, "radius_km": {"-5"}},
+		{"near": {"0,0"}, "radius_km": {"far"}},
+	} {
+		_, err := ParseNearQuery(values)
+		assert.Error(t, err, values.Encode())
+	}
+}
+
+func TestLaunchpadsNear(t *testing.T) {
+	// From Orlando the Cape sites come first, then Texas, then California
+	nearby := LaunchpadsNear(testLaunchpads, NearQuery{Latitude: 28.5383, Longitude: -81.3792})
+	require.Len(t, nearby, 4)
+	assert.Equal(t, "ksc", nearby[0].ID)
+	assert.Equal(t, "ccsfs", nearby[1].ID)
+	assert.Equal(t, "boca", nearby[2].ID)
+	assert.Equal(t, "vafb", nearby[3].ID)
+	for i := 1; i < len(nearby); i++ {
+		assert.LessOrEqual(t, nearby[i-1].DistanceKm, nearby[i].DistanceKm)
+	}
+
+	nearby = LaunchpadsNear(testLaunchpads, NearQuery{Latitude: 28.5383, Longitude: -81.3792, RadiusKm: 100})
+	require.Len(t, nearby, 2)
+	assert.Equal(t, "ksc", nearby[0].ID)
+	assert.InDelta(t, 76, nearby[0].DistanceKm, 5)
+
+	assert.Empty(t, LaunchpadsNear(testLaunchpads, NearQuery{Latitude: -33.9, Longitude: 18.4, RadiusKm: 1000}))
+}
+
+func TestSpaceXClient_GetAllLaunchpads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/launchpads", r.URL.Path)
+		assert.Equal(t, "GET", r.Method)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"id":"5e9e4502f509094188566f88","name":"KSC LC 39A","full_name":"Kennedy Space Center Historic Launch Complex 39A","locality":"Cape Canaveral","region":"Florida","timezone":"America/New_York","latitude":28.6080585,"longitude":-80.6039558,"launch_attempts":55,"launch_successes":55,"status":"active","rockets":["5e9d0d95eda69973a809d1ec"],"launches":[]}]`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	launchpads, err := client.GetAllLaunchpads(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, launchpads, 1)
+	assert.Equal(t, "KSC LC 39A", launchpads[0].Name)
+	assert.Equal(t, "Florida", launchpads[0].Region)
+	assert.Equal(t, 28.6080585, launchpads[0].Latitude)
+	assert.Equal(t, -80.6039558, launchpads[0].Longitude)
+	assert.Equal(t, 55, launchpads[0].LaunchAttempts)
+	assert.Equal(t, 55, launchpads[0].LaunchSuccesses)
+	assert.Equal(t, "active", launchpads[0].Status)
+}
+
+func TestSpaceXClient_GetLaunchpad(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/launchpads/5e9e4501f509094ba4566f84", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"5e9e4501f509094ba4566f84","name":"CCSFS SLC 40","region":"Florida","latitude":28.5618571,"longitude":-80.577366,"launch_attempts":99,"launch_successes":97}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	launchpad, err := client.GetLaunchpad(context.Background(), "5e9e4501f509094ba4566f84")
+
+	require.NoError(t, err)
+	assert.Equal(t, "CCSFS SLC 40", launchpad.Name)
+	assert.Equal(t, 97, launchpad.LaunchSuccesses)
+}
+
+func TestSpaceXClient_GetLaunchpad_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	launchpad, err := client.GetLaunchpad(context.Background(), "unknown")
+
+	assert.ErrorIs(t, err, upstream.ErrNotFound)
+	assert.Nil(t, launchpad)
+}
+
+func TestSpaceXClient_GetAllLandpads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v4/landpads", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"id":"5e9e3032383ecb267a34e7c7","name":"LZ-1","full_name":"Landing Zone 1","type":"RTLS","locality":"Cape Canaveral","region":"Florida","latitude":28.485833,"longitude":-80.544444,"landing_attempts":15,"landing_successes":14,"status":"active"},
+			{"id":"5e9e3033383ecbb9e534e7cc","name":"OCISLY","full_name":"Of Course I Still Love You","type":"ASDS","locality":"Port Canaveral","region":"Florida","latitude":28.4104,"longitude":-80.6188,"landing_attempts":36,"landing_successes":35,"status":"active"}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	landpads, err := client.GetAllLandpads(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, landpads, 2)
+	assert.Equal(t, "LZ-1", landpads[0].Name)
+	assert.Equal(t, "RTLS", landpads[0].Type)
+	assert.Equal(t, 15, landpads[0].LandingAttempts)
+	assert.Equal(t, 14, landpads[0].LandingSuccesses)
+	assert.Equal(t, "ASDS", landpads[1].Type)
+	assert.Equal(t, 28.4104, landpads[1].Latitude)
+}
@@ -0,0 +1,152 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"outerspace-go/lib/requestid"
+	"outerspace-go/lib/upstream"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ProblemContentType is the media type of RFC 7807 error bodies
+const ProblemContentType = "application/problem+json"
+
+// Stable error codes reported in Problem.Code
+const (
+	CodeInvalidArgument     = "invalid_argument"
+	CodeUpstreamNotFound    = "upstream_not_found"
+	CodeUpstreamRateLimited = "upstream_rate_limited"
+	CodeUpstreamUnavailable = "upstream_unavailable"
+	CodeUpstreamBadPayload  = "upstream_bad_payload"
+	CodeUpstreamTimeout     = "upstream_timeout"
+	CodeInternal            = "internal"
+	CodeCanceled            = "canceled"
+)
+
+// StatusClientClosedRequest is the non-standard status, borrowed from nginx,
+// reported when the caller went away before the request completed
+const StatusClientClosedRequest = 499
+
+// Problem is an RFC 7807 problem details body returned for every failed API
+// request
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	// Code is a stable, machine-readable error code
+	Code string `json:"code"`
+	// RequestID identifies the request in our logs
+	RequestID string `json:"request_id,omitempty"`
+	// Upstream names the upstream API that failed, if any
+	Upstream string `json:"upstream,omitempty"`
+}
+
+// writeProblem writes p as the response to r
+func writeProblem(w http.ResponseWriter, r *http.Request, p Problem) {
+	p.Type = "about:blank"
+	p.Title = statusTitle(p.Status)
+	p.Instance = r.URL.Path
+	p.RequestID = requestid.FromContext(r.Context())
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// statusTitle returns the text of an HTTP status code, including the
+// non-standard ones we report
+func statusTitle(status int) string {
+	if status == StatusClientClosedRequest {
+		return "Client Closed Request"
+	}
+	return http.StatusText(status)
+}
+
+// writeBadRequest reports an invalid request
+func writeBadRequest(w http.ResponseWriter, r *http.Request, detail string) {
+	writeProblem(w, r, Problem{Status: http.StatusBadRequest, Code: CodeInvalidArgument, Detail: detail})
+}
+
+// writeError reports a failed call to an upstream client. The error itself
+// is only logged; callers get a problem describing the kind of failure.
+// Requests the caller gave up on are not a fault of ours and only logged at
+// debug level.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	p := problemFor(err)
+
+	event := log.Error()
+	if p.Code == CodeCanceled {
+		event = log.Debug()
+	}
+	event.
+		Err(err).
+		Str("request_id", requestid.FromContext(r.Context())).
+		Str("path", r.URL.Path).
+		Str("code", p.Code).
+		Msg("Request failed")
+
+	writeProblem(w, r, p)
+}
+
+// itemProblem reports why one item of a batch request failed. Like
+// writeError, the error itself is only logged.
+func itemProblem(r *http.Request, id string, err error) *Problem {
+	p := problemFor(err)
+	p.Type = "about:blank"
+	p.Title = statusTitle(p.Status)
+
+	event := log.Warn()
+	if p.Code == CodeCanceled {
+		event = log.Debug()
+	}
+	event.
+		Err(err).
+		Str("request_id", requestid.FromContext(r.Context())).
+		Str("path", r.URL.Path).
+		Str("id", id).
+		Str("code", p.Code).
+		Msg("Batch item failed")
+
+	return &p
+}
+
+// problemFor maps an error from an upstream client to the problem returned
+// to our callers
+func problemFor(err error) Problem {
+	var upstreamErr *upstream.Error
+	if !errors.As(err, &upstreamErr) {
+		// upstream.Classify passes the caller's own cancellation through
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return Problem{Status: StatusClientClosedRequest, Code: CodeCanceled, Detail: "The request was canceled before it completed."}
+		}
+		return Problem{Status: http.StatusInternalServerError, Code: CodeInternal, Detail: "The request could not be completed."}
+	}
+
+	p := Problem{Upstream: upstreamErr.Upstream}
+	switch upstreamErr.Kind {
+	case upstream.ErrNotFound:
+		p.Status, p.Code = http.StatusNotFound, CodeUpstreamNotFound
+		p.Detail = fmt.Sprintf("The %s API does not know the requested resource.", upstreamErr.Upstream)
+	case upstream.ErrRateLimited:
+		p.Status, p.Code = http.StatusTooManyRequests, CodeUpstreamRateLimited
+		p.Detail = fmt.Sprintf("The %s API is rate limiting requests, try again later.", upstreamErr.Upstream)
+	case upstream.ErrTimeout:
+		p.Status, p.Code = http.StatusGatewayTimeout, CodeUpstreamTimeout
+		p.Detail = fmt.Sprintf("The %s API did not answer in time.", upstreamErr.Upstream)
+	case upstream.ErrBadPayload:
+		p.Status, p.Code = http.StatusBadGateway, CodeUpstreamBadPayload
+		p.Detail = fmt.Sprintf("The %s API returned a response that could not be read.", upstreamErr.Upstream)
+	default:
+		p.Status, p.Code = http.StatusBadGateway, CodeUpstreamUnavailable
+		p.Detail = fmt.Sprintf("The %s API is unavailable.", upstreamErr.Upstream)
+	}
+	return p
+}
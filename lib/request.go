@@ -0,0 +1,69 @@
+package lib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"outerspace-go/lib/upstream"
+)
+
+// get issues a GET request to an upstream API. The request is bound to ctx so
+// that cancellation and deadlines of the caller propagate to the upstream.
+func get(ctx context.Context, httpClient *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return httpClient.Do(req)
+}
+
+// getJSON issues a GET request to the named upstream and decodes the JSON
+// response into v. Failures are reported as *upstream.Error.
+func getJSON(ctx context.Context, httpClient *http.Client, name, url string, v any) error {
+	resp, err := get(ctx, httpClient, url)
+	if err != nil {
+		return upstream.Classify(name, err)
+	}
+	defer resp.Body.Close()
+
+	return decodeJSON(name, resp, v)
+}
+
+// postQuery sends body as JSON in a POST request that only reads data, such
+// as the query endpoints of the SpaceX API, and decodes the JSON response
+// into v. The request is marked idempotent so that it is retried like a GET.
+// Failures are reported as *upstream.Error.
+func postQuery(ctx context.Context, httpClient *http.Client, name, url string, body, v any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header["Idempotency-Key"] = nil
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return upstream.Classify(name, err)
+	}
+	defer resp.Body.Close()
+
+	return decodeJSON(name, resp, v)
+}
+
+// decodeJSON checks the status of a response from the named upstream before
+// decoding its JSON body into v
+func decodeJSON(name string, resp *http.Response, v any) error {
+	if err := upstream.CheckStatus(name, resp); err != nil {
+		return err
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return upstream.BadPayload(name, err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Header is the HTTP header, and lower-cased the gRPC metadata key, that
+// carries the request id between services
+const Header = "X-Request-Id"
+
+// maxLength bounds request ids accepted from callers so they cannot flood logs
+const maxLength = 128
+
+type contextKey struct{}
+
+// New returns a new random request id
+func New() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// FromCaller returns the request id sent by the caller if it is usable, or a
+// new one otherwise
+func FromCaller(id string) string {
+	if id == "" || len(id) > maxLength {
+		return New()
+	}
+	for _, c := range id {
+		if c < 0x21 || c > 0x7e {
+			return New()
+		}
+	}
+	return id
+}
+
+// NewContext returns a copy of ctx carrying the request id
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request id carried by ctx, or "" if there is none
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
@@ -0,0 +1,34 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	id := New()
+
+	assert.Len(t, id, 32)
+	assert.NotEqual(t, id, New())
+}
+
+func TestFromCaller(t *testing.T) {
+	assert.Equal(t, "abc-123", FromCaller("abc-123"))
+
+	// Missing, oversized or unprintable ids are replaced
+	for _, id := range []string{"", strings.Repeat("a", maxLength+1), "has space", "line\nbreak"} {
+		got := FromCaller(id)
+		assert.NotEqual(t, id, got)
+		assert.Len(t, got, 32)
+	}
+}
+
+func TestContext(t *testing.T) {
+	assert.Empty(t, FromContext(context.Background()))
+
+	ctx := NewContext(context.Background(), "abc-123")
+	assert.Equal(t, "abc-123", FromContext(ctx))
+}
@@ -0,0 +1,64 @@
+package secret
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// File is a secret stored in a file, such as a mounted Kubernetes Secret.
+// The file is re-read whenever its modification time or size changes, so
+// rotated secrets are picked up without a restart.
+type File struct {
+	path string
+
+	mu      sync.Mutex
+	value   string
+	modTime time.Time
+	size    int64
+}
+
+// NewFile loads the secret at path, failing if it cannot be read or is empty
+func NewFile(path string) (*File, error) {
+	f := &File{path: path}
+	if _, err := f.Value(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Value returns the current secret with surrounding whitespace trimmed. If the
+// file has become unreadable the last good value is returned with the error.
+func (f *File) Value() (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return f.value, fmt.Errorf("reading secret file: %w", err)
+	}
+	if f.value != "" && info.ModTime().Equal(f.modTime) && info.Size() == f.size {
+		return f.value, nil
+	}
+
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return f.value, fmt.Errorf("reading secret file: %w", err)
+	}
+	value := strings.TrimSpace(string(data))
+	if value == "" {
+		return f.value, fmt.Errorf("secret file %s is empty", f.path)
+	}
+
+	f.value = value
+	f.modTime = info.ModTime()
+	f.size = info.Size()
+	return f.value, nil
+}
+
+// Path returns the location of the secret file
+func (f *File) Path() string {
+	return f.path
+}
@@ -0,0 +1,67 @@
+package secret
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, os.WriteFile(path, []byte("s3cret\n"), 0o600))
+
+	f, err := NewFile(path)
+	require.NoError(t, err)
+
+	value, err := f.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cret", value)
+	assert.Equal(t, path, f.Path())
+}
+
+func TestNewFile_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	empty := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(empty, []byte("  \n"), 0o600))
+
+	_, err := NewFile(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+
+	_, err = NewFile(empty)
+	assert.Error(t, err)
+}
+
+func TestFile_ReloadsOnChange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o600))
+
+	f, err := NewFile(path)
+	require.NoError(t, err)
+
+	// Rotate the secret, making sure the modification time moves on even on
+	// filesystems with coarse timestamps
+	require.NoError(t, os.WriteFile(path, []byte("new"), 0o600))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+
+	value, err := f.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "new", value)
+}
+
+func TestFile_KeepsLastValueWhenRemoved(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, os.WriteFile(path, []byte("s3cret"), 0o600))
+
+	f, err := NewFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(path))
+
+	value, err := f.Value()
+	assert.Error(t, err)
+	assert.Equal(t, "s3cret", value)
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+)
+
+// ErrShutdownTimeout is returned by Run when in-flight requests did not
+// finish within the shutdown timeout and had to be cut off
+var ErrShutdownTimeout = errors.New("graceful shutdown timed out")
+
+// Server runs the HTTP and gRPC servers side by side and shuts them down together
+type Server struct {
+	HTTP *http.Server
+	// HTTPListener serves HTTP in plaintext; it may be nil when
+	// HTTPSListener is set
+	HTTPListener net.Listener
+	// HTTPSListener, if set, serves HTTP over TLS using HTTP.TLSConfig
+	HTTPSListener net.Listener
+	GRPC          *grpc.Server
+	GRPCListener  net.Listener
+	// HealthGRPC, if set, serves health checks in plaintext on
+	// HealthGRPCListener, for probes that cannot use TLS
+	HealthGRPC         *grpc.Server
+	HealthGRPCListener net.Listener
+	// Stopping, if set, is closed when shutdown starts, before the servers
+	// are drained, so that long-lived streams such as WatchLatestLaunch can
+	// end instead of holding up GracefulStop; see grpc.WithShutdown
+	Stopping chan struct{}
+	// ShutdownTimeout bounds how long in-flight requests may take to drain
+	ShutdownTimeout time.Duration
+}
+
+// Run serves on both listeners until ctx is cancelled or one of the servers
+// fails. Either way both servers then stop accepting new connections and
+// in-flight requests are drained, up to ShutdownTimeout. Run returns nil
+// after a clean shutdown triggered by ctx.
+func (s *Server) Run(ctx context.Context) error {
+	errCh := make(chan error, 4)
+
+	if s.HTTPListener != nil {
+		go func() {
+			log.Info().Str("addr", s.HTTPListener.Addr().String()).Msg("Starting HTTP server")
+			if err := s.HTTP.Serve(s.HTTPListener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("http server: %w", err)
+			}
+		}()
+	}
+
+	if s.HTTPSListener != nil {
+		go func() {
+			log.Info().Str("addr", s.HTTPSListener.Addr().String()).Msg("Starting HTTPS server")
+			if err := s.HTTP.ServeTLS(s.HTTPSListener, "", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errCh <- fmt.Errorf("https server: %w", err)
+			}
+		}()
+	}
+
+	go func() {
+		log.Info().Str("addr", s.GRPCListener.Addr().String()).Msg("Starting gRPC server")
+		if err := s.GRPC.Serve(s.GRPCListener); err != nil {
+			errCh <- fmt.Errorf("grpc server: %w", err)
+		}
+	}()
+
+	if s.HealthGRPC != nil {
+		go func() {
+			log.Info().Str("addr", s.HealthGRPCListener.Addr().String()).Msg("Starting gRPC health server")
+			if err := s.HealthGRPC.Serve(s.HealthGRPCListener); err != nil {
+				errCh <- fmt.Errorf("grpc health server: %w", err)
+			}
+		}()
+	}
+
+	var serveErr error
+	select {
+	case <-ctx.Done():
+		log.Info().Msg("Shutdown signal received, draining servers")
+	case serveErr = <-errCh:
+		log.Error().Err(serveErr).Msg("Server failed, shutting down")
+	}
+
+	return errors.Join(serveErr, s.shutdown())
+}
+
+// shutdown drains both servers concurrently, forcing them closed once the
+// shutdown timeout has elapsed
+func (s *Server) shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.ShutdownTimeout)
+	defer cancel()
+
+	if s.Stopping != nil {
+		close(s.Stopping)
+	}
+
+	grpcDone := make(chan struct{})
+	go func() {
+		s.GRPC.GracefulStop()
+		if s.HealthGRPC != nil {
+			s.HealthGRPC.GracefulStop()
+		}
+		close(grpcDone)
+	}()
+
+	var timedOut bool
+	if err := s.HTTP.Shutdown(ctx); err != nil {
+		timedOut = true
+		s.HTTP.Close()
+	}
+
+	select {
+	case <-grpcDone:
+	case <-ctx.Done():
+		timedOut = true
+		s.GRPC.Stop()
+		if s.HealthGRPC != nil {
+			s.HealthGRPC.Stop()
+		}
+		<-grpcDone
+	}
+
+	if timedOut {
+		return fmt.Errorf("%w after %s", ErrShutdownTimeout, s.ShutdownTimeout)
+	}
+	log.Info().Msg("Servers drained")
+	return nil
+}
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"outerspace-go/lib"
+	spacegrpc "outerspace-go/lib/grpc"
+	spacehttp "outerspace-go/lib/http"
+	"outerspace-go/lib/tlsconfig"
+	"outerspace-go/lib/tlsconfig/tlstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// slowLaunchService answers GetMathFact only after a delay so a request can
+// still be in flight when shutdown starts
+type slowLaunchService struct {
+	spacegrpc.UnimplementedLaunchServiceServer
+	started chan struct{}
+	delay   time.Duration
+}
+
+func (s *slowLaunchService) GetMathFact(ctx context.Context, req *spacegrpc.GetMathFactRequest) (*spacegrpc.MathFact, error) {
+	close(s.started)
+	time.Sleep(s.delay)
+	return &spacegrpc.MathFact{Text: "done", Number: 42}, nil
+}
+
+// newTestServer builds a Server on loopback listeners whose HTTP handler and
+// gRPC method both block for delay after signalling on their started channel
+func newTestServer(t *testing.T, delay, shutdownTimeout time.Duration) (*Server, chan struct{}, *slowLaunchService) {
+	httpStarted := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(httpStarted)
+		time.Sleep(delay)
+		w.Write([]byte("done"))
+	})
+
+	svc := &slowLaunchService{started: make(chan struct{}), delay: delay}
+	grpcServer := grpc.NewServer()
+	spacegrpc.RegisterLaunchServiceServer(grpcServer, svc)
+
+	httpLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	return &Server{
+		HTTP:            &http.Server{Handler: mux},
+		HTTPListener:    httpLis,
+		GRPC:            grpcServer,
+		GRPCListener:    grpcLis,
+		ShutdownTimeout: shutdownTimeout,
+	}, httpStarted, svc
+}
+
+func run(ctx context.Context, srv *Server) chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Run(ctx)
+	}()
+	return done
+}
+
+func TestRun_DrainsInFlightHTTPRequest(t *testing.T) {
+	srv, started, _ := newTestServer(t, 200*time.Millisecond, 5*time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := run(ctx, srv)
+
+	type result struct {
+		status int
+		body   string
+		err    error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		resp, err := http.Get("http://" + srv.HTTPListener.Addr().String() + "/slow")
+		if err != nil {
+			resCh <- result{err: err}
+			return
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		resCh <- result{status: resp.StatusCode, body: string(body), err: err}
+	}()
+
+	<-started
+	cancel()
+
+	res := <-resCh
+	require.NoError(t, res.err)
+	assert.Equal(t, http.StatusOK, res.status)
+	assert.Equal(t, "done", res.body)
+	assert.NoError(t, <-done)
+
+	// The listener is closed once shutdown has completed
+	_, err := http.Get("http://" + srv.HTTPListener.Addr().String() + "/slow")
+	assert.Error(t, err)
+}
+
+func TestRun_DrainsInFlightGRPCRequest(t *testing.T) {
+	srv, _, svc := newTestServer(t, 200*time.Millisecond, 5*time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := run(ctx, srv)
+
+	conn, err := grpc.NewClient(srv.GRPCListener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := spacegrpc.NewLaunchServiceClient(conn)
+
+	type result struct {
+		fact *spacegrpc.MathFact
+		err  error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		fact, err := client.GetMathFact(context.Background(), &spacegrpc.GetMathFactRequest{})
+		resCh <- result{fact: fact, err: err}
+	}()
+
+	<-svc.started
+	cancel()
+
+	res := <-resCh
+	require.NoError(t, res.err)
+	assert.Equal(t, "done", res.fact.Text)
+	assert.NoError(t, <-done)
+}
+
+func TestRun_ShutdownTimeout(t *testing.T) {
+	srv, started, _ := newTestServer(t, 2*time.Second, 50*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := run(ctx, srv)
+
+	go http.Get("http://" + srv.HTTPListener.Addr().String() + "/slow")
+
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, ErrShutdownTimeout)
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after the shutdown timeout")
+	}
+}
+
+func TestRun_ServeError(t *testing.T) {
+	srv, _, _ := newTestServer(t, 0, time.Second)
+	// A closed listener makes Serve fail immediately
+	srv.GRPCListener.Close()
+
+	err := srv.Run(context.Background())
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrShutdownTimeout)
+}
+
+func TestRun_HTTPSOnly(t *testing.T) {
+	srv, _, _ := newTestServer(t, 0, time.Second)
+	srv.HTTP.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"number": 42, "text": "42 is the answer", "found": true}`))
+	})
+
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteKeyPair(t, dir, "server")
+	serverConfig, err := tlsconfig.Server(certFile, keyFile, "")
+	require.NoError(t, err)
+	srv.HTTP.TLSConfig = serverConfig
+	srv.HTTPListener.Close()
+	srv.HTTPListener = nil
+	srv.HTTPSListener, err = net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := run(ctx, srv)
+
+	clientConfig, err := tlsconfig.Client(ca.WriteCert(t, dir), "", "")
+	require.NoError(t, err)
+	client := spacehttp.NewClient("https://"+srv.HTTPSListener.Addr().String(), spacehttp.WithTLS(clientConfig))
+	fact, err := client.GetMathFact(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(42), fact.Number)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestRun_HealthGRPC(t *testing.T) {
+	srv, _, _ := newTestServer(t, 0, time.Second)
+	srv.HealthGRPC = spacegrpc.NewHealthServer(health.NewServer())
+	var err error
+	srv.HealthGRPCListener, err = net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := run(ctx, srv)
+
+	conn, err := grpc.NewClient(srv.HealthGRPCListener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	resp, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	cancel()
+	assert.NoError(t, <-done)
+
+	// The health listener is closed along with the others
+	_, err = net.Dial("tcp", srv.HealthGRPCListener.Addr().String())
+	assert.Error(t, err)
+}
+
+// fakeSpaceX answers GetLatestLaunch with the same launch every time
+type fakeSpaceX struct {
+	lib.SpaceXClientInterface
+}
+
+func (fakeSpaceX) GetLatestLaunch(ctx context.Context) (*lib.Launch, error) {
+	return &lib.Launch{FlightNumber: 1, MissionName: "First"}, nil
+}
+
+func TestRun_EndsWatchStreams(t *testing.T) {
+	srv, _, _ := newTestServer(t, 0, 5*time.Second)
+	srv.Stopping = make(chan struct{})
+	srv.GRPC = spacegrpc.New(fakeSpaceX{}, nil, nil, spacegrpc.WithShutdown(srv.Stopping))
+	ctx, cancel := context.WithCancel(context.Background())
+	done := run(ctx, srv)
+
+	conn, err := grpc.NewClient(srv.GRPCListener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	stream, err := spacegrpc.NewLaunchServiceClient(conn).WatchLatestLaunch(context.Background(), &spacegrpc.WatchLatestLaunchRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	// The open stream must not hold up the drain until the timeout
+	start := time.Now()
+	cancel()
+
+	assert.NoError(t, <-done)
+	assert.Less(t, time.Since(start), time.Second)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
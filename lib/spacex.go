@@ -1,12 +1,10 @@
 package lib
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"net/http"
-	"time"
-
-	"github.com/rs/zerolog/log"
+	"net/url"
 )
 
 // SpaceXClient handles API calls to SpaceX
@@ -17,11 +15,20 @@ type SpaceXClient struct {
 
 // Response structures for SpaceX API
 type Launch struct {
+	ID           string `json:"id"`
 	FlightNumber int    `json:"flight_number"`
 	MissionName  string `json:"name"`
 	DateUTC      string `json:"date_utc"`
-	Success      bool   `json:"success"`
-	Details      string `json:"details"`
+	// DatePrecision is how precisely DateUTC is known: half, quarter, year,
+	// month, day or hour
+	DatePrecision string `json:"date_precision"`
+	// Success is unset until the outcome of the launch is known, as for
+	// every upcoming launch
+	Success  *bool  `json:"success"`
+	Upcoming bool   `json:"upcoming"`
+	Details  string `json:"details"`
+	// RocketID is the ID of the rocket that flew the launch
+	RocketID string `json:"rocket"`
 }
 
 type Rocket struct {
@@ -43,67 +50,18 @@ type RocketSummary struct {
 }
 
 // NewSpaceXClient creates a new SpaceX API client
-func NewSpaceXClient() *SpaceXClient {
+func NewSpaceXClient(opts ...ClientOption) *SpaceXClient {
+	o := newClientOptions(DefaultSpaceXBaseURL, opts)
 	return &SpaceXClient{
-		baseURL: "https://api.spacexdata.com/v4",
-		httpClient: &http.Client{
-			Timeout: time.Second * 10,
-		},
-	}
-}
-
-// Add logging to API calls
-func (c *SpaceXClient) makeRequest(method, url string) (*http.Response, error) {
-	start := time.Now()
-
-	req, err := http.NewRequest(method, url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	duration := time.Since(start)
-
-	if err != nil {
-		log.Error().
-			Str("method", method).
-			Str("host", req.URL.Host).
-			Dur("latency", duration).
-			Err(err).
-			Msg("API request failed")
-		return nil, err
-	}
-
-	// Log X-prefixed headers
-	for header, values := range resp.Header {
-		if len(header) > 0 && (header[0] == 'X' || header[0] == 'x') {
-			log.Info().
-				Str("header", header).
-				Strs("values", values).
-				Msg("X-Header found")
-		}
+		baseURL:    o.baseURL,
+		httpClient: o.client(SpaceXUpstream),
 	}
-
-	log.Info().
-		Str("method", method).
-		Str("host", req.URL.Host).
-		Int("status", resp.StatusCode).
-		Dur("latency", duration).
-		Msg("API request completed")
-
-	return resp, nil
 }
 
 // GetAllRockets fetches all rocket summaries
-func (c *SpaceXClient) GetAllRockets() ([]RocketSummary, error) {
-	resp, err := c.makeRequest("GET", fmt.Sprintf("%s/rockets", c.baseURL))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
+func (c *SpaceXClient) GetAllRockets(ctx context.Context) ([]RocketSummary, error) {
 	var rockets []Rocket
-	if err := json.NewDecoder(resp.Body).Decode(&rockets); err != nil {
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/rockets", c.baseURL), &rockets); err != nil {
 		return nil, err
 	}
 
@@ -119,30 +77,18 @@ func (c *SpaceXClient) GetAllRockets() ([]RocketSummary, error) {
 }
 
 // GetLatestLaunch fetches details of the latest SpaceX launch
-func (c *SpaceXClient) GetLatestLaunch() (*Launch, error) {
-	resp, err := c.makeRequest("GET", fmt.Sprintf("%s/launches/latest", c.baseURL))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
+func (c *SpaceXClient) GetLatestLaunch(ctx context.Context) (*Launch, error) {
 	var launch Launch
-	if err := json.NewDecoder(resp.Body).Decode(&launch); err != nil {
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/launches/latest", c.baseURL), &launch); err != nil {
 		return nil, err
 	}
 	return &launch, nil
 }
 
 // GetRocket fetches details of a specific rocket by its ID
-func (c *SpaceXClient) GetRocket(rocketID string) (*Rocket, error) {
-	resp, err := c.makeRequest("GET", fmt.Sprintf("%s/rockets/%s", c.baseURL, rocketID))
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
+func (c *SpaceXClient) GetRocket(ctx context.Context, rocketID string) (*Rocket, error) {
 	var rocket Rocket
-	if err := json.NewDecoder(resp.Body).Decode(&rocket); err != nil {
+	if err := getJSON(ctx, c.httpClient, SpaceXUpstream, fmt.Sprintf("%s/rockets/%s", c.baseURL, url.PathEscape(rocketID)), &rocket); err != nil {
 		return nil, err
 	}
 	return &rocket, nil
@@ -1,9 +1,13 @@
 package lib
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+
+	"outerspace-go/lib/upstream"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -22,11 +26,10 @@ func TestSpaceXClient_GetAllRockets(t *testing.T) {
 	defer server.Close()
 
 	// Create client with test server URL
-	client := NewSpaceXClient()
-	client.baseURL = server.URL + "/v4"
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
 
 	// Call the method
-	rockets, err := client.GetAllRockets()
+	rockets, err := client.GetAllRockets(context.Background())
 
 	// Assert results
 	assert.NoError(t, err)
@@ -51,11 +54,10 @@ func TestSpaceXClient_GetRocket(t *testing.T) {
 	defer server.Close()
 
 	// Create client with test server URL
-	client := NewSpaceXClient()
-	client.baseURL = server.URL + "/v4"
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
 
 	// Call the method
-	rocket, err := client.GetRocket("123")
+	rocket, err := client.GetRocket(context.Background(), "123")
 
 	// Assert results
 	assert.NoError(t, err)
@@ -80,17 +82,109 @@ func TestSpaceXClient_GetLatestLaunch(t *testing.T) {
 	defer server.Close()
 
 	// Create client with test server URL
-	client := NewSpaceXClient()
-	client.baseURL = server.URL + "/v4"
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
 
 	// Call the method
-	launch, err := client.GetLatestLaunch()
+	launch, err := client.GetLatestLaunch(context.Background())
 
 	// Assert results
 	assert.NoError(t, err)
 	assert.Equal(t, 100, launch.FlightNumber)
 	assert.Equal(t, "Mission X", launch.MissionName)
 	assert.Equal(t, "2023-01-01T12:00:00Z", launch.DateUTC)
-	assert.True(t, launch.Success)
+	assert.Equal(t, true, *launch.Success)
 	assert.Equal(t, "Test mission", launch.Details)
 }
+
+func TestSpaceXClient_GetLatestLaunch_ContextCancelled(t *testing.T) {
+	// The server only answers once the client has gone away
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	launch, err := client.GetLatestLaunch(ctx)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorIs(t, err, upstream.ErrTimeout)
+	assert.Nil(t, launch)
+	assert.Less(t, time.Since(start), DefaultTimeout)
+}
+
+func TestSpaceXClient_GetLatestLaunch_RetriesTransientFailure(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"flight_number":100,"name":"Mission X"}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(
+		WithBaseURL(server.URL+"/v4"),
+		WithRetryPolicy(upstream.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond}),
+	)
+
+	launch, err := client.GetLatestLaunch(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 100, launch.FlightNumber)
+	assert.Equal(t, 2, calls)
+}
+
+func TestSpaceXClient_GetRocket_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`Not Found`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	rocket, err := client.GetRocket(context.Background(), "unknown")
+
+	var upstreamErr *upstream.Error
+	assert.ErrorAs(t, err, &upstreamErr)
+	assert.ErrorIs(t, err, upstream.ErrNotFound)
+	assert.Equal(t, SpaceXUpstream, upstreamErr.Upstream)
+	assert.Equal(t, http.StatusNotFound, upstreamErr.StatusCode)
+	assert.Nil(t, rocket)
+}
+
+func TestSpaceXClient_GetRocket_EscapesID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The ID stays a single path segment instead of reaching another resource
+		assert.Equal(t, "/v4/rockets/..%2Flaunches%2Flatest", r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	_, err := client.GetRocket(context.Background(), "../launches/latest")
+
+	assert.ErrorIs(t, err, upstream.ErrNotFound)
+}
+
+func TestSpaceXClient_GetAllRockets_BadPayload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html>maintenance</html>`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceXClient(WithBaseURL(server.URL + "/v4"))
+
+	rockets, err := client.GetAllRockets(context.Background())
+
+	assert.ErrorIs(t, err, upstream.ErrBadPayload)
+	assert.Nil(t, rockets)
+}
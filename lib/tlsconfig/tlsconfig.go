@@ -0,0 +1,200 @@
+// Package tlsconfig builds TLS configurations for our servers and clients
+// from PEM files. Like secret.File, the files are re-read whenever their
+// modification time or size changes, so rotated certificates are picked up
+// by new connections without a restart.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// nextProtos are the ALPN protocols offered by servers. net/http only adds
+// h2 and http/1.1 to the outer config, not to the per-handshake config
+// returned by GetConfigForClient, so they are set there explicitly.
+var nextProtos = []string{"h2", "http/1.1"}
+
+// fileStamp identifies a version of a file
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func (s fileStamp) equal(other fileStamp) bool {
+	return s.modTime.Equal(other.modTime) && s.size == other.size
+}
+
+// stamp returns the current version of the file at path
+func stamp(path string) (fileStamp, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// reloader caches a value built from a set of files and rebuilds it whenever
+// one of them changes. If a rebuild fails the last good value is kept.
+type reloader[T any] struct {
+	paths []string
+	load  func() (T, error)
+
+	mu     sync.Mutex
+	value  T
+	stamps []fileStamp
+}
+
+func newReloader[T any](load func() (T, error), paths ...string) (*reloader[T], error) {
+	r := &reloader[T]{paths: paths, load: load}
+	if _, err := r.get(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// get returns the current value, rebuilding it first if a file has changed
+func (r *reloader[T]) get() (T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stamps := make([]fileStamp, len(r.paths))
+	changed := r.stamps == nil
+	for i, path := range r.paths {
+		s, err := stamp(path)
+		if err != nil {
+			return r.value, err
+		}
+		stamps[i] = s
+		if !changed && !s.equal(r.stamps[i]) {
+			changed = true
+		}
+	}
+	if !changed {
+		return r.value, nil
+	}
+
+	value, err := r.load()
+	if err != nil {
+		return r.value, err
+	}
+	if r.stamps != nil {
+		log.Info().Strs("files", r.paths).Msg("Reloaded TLS files")
+	}
+	r.value = value
+	r.stamps = stamps
+	return r.value, nil
+}
+
+// current is like get for use during handshakes: a failed reload is logged
+// and the last good value is used, so a half-written rotation does not
+// break new connections
+func (r *reloader[T]) current() T {
+	value, err := r.get()
+	if err != nil {
+		log.Warn().Err(err).Strs("files", r.paths).Msg("Reloading TLS files failed, using the previous version")
+	}
+	return value
+}
+
+// keyPair loads a certificate and its private key
+func keyPair(certFile, keyFile string) (*reloader[*tls.Certificate], error) {
+	r, err := newReloader(func() (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &cert, nil
+	}, certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading TLS key pair: %w", err)
+	}
+	return r, nil
+}
+
+// certPool loads a bundle of PEM encoded CA certificates
+func certPool(caFile string) (*reloader[*x509.CertPool], error) {
+	r, err := newReloader(func() (*x509.CertPool, error) {
+		data, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no PEM certificates found in %s", caFile)
+		}
+		return pool, nil
+	}, caFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading CA bundle: %w", err)
+	}
+	return r, nil
+}
+
+// Server returns the TLS configuration of a server presenting the key pair
+// in certFile and keyFile. When clientCAFile is set, clients must present a
+// certificate signed by one of the CAs it holds (mutual TLS).
+func Server(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("a TLS certificate and key file are required")
+	}
+	cert, err := keyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	var clientCAs *reloader[*x509.CertPool]
+	if clientCAFile != "" {
+		if clientCAs, err = certPool(clientCAFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+				Certificates: []tls.Certificate{*cert.current()},
+			}
+			if clientCAs != nil {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = clientCAs.current()
+			}
+			return cfg, nil
+		},
+	}, nil
+}
+
+// Client returns the TLS configuration of a client. Servers are verified
+// against the CAs in caFile, or the system roots when it is empty. When
+// certFile and keyFile are set, that key pair is presented to servers that
+// ask for a client certificate (mutual TLS).
+func Client(caFile, certFile, keyFile string) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		// The roots are read once, so a new CA bundle is only trusted by
+		// clients created after it was rotated
+		pool, err := certPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool.current()
+	}
+	if certFile != "" || keyFile != "" {
+		cert, err := keyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return cert.current(), nil
+		}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,209 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"outerspace-go/lib/tlsconfig/tlstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveTLS serves a handler answering with the request protocol over TLS
+// with config and returns the server's URL
+func serveTLS(t *testing.T, config *tls.Config) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(r.Proto))
+		}),
+		TLSConfig: config,
+	}
+	go srv.ServeTLS(lis, "", "")
+	t.Cleanup(func() { srv.Close() })
+
+	return "https://" + lis.Addr().String()
+}
+
+// httpClient returns a client using config that never reuses connections,
+// so that every request makes a new handshake
+func httpClient(config *tls.Config) *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		TLSClientConfig:   config,
+		ForceAttemptHTTP2: true,
+		DisableKeepAlives: true,
+	}}
+}
+
+// peerCommonName returns the common name of the certificate the server at
+// url presents
+func peerCommonName(t *testing.T, client *http.Client, url string) string {
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp.TLS.PeerCertificates[0].Subject.CommonName
+}
+
+// touch moves the modification time of path on, even on filesystems with
+// coarse timestamps
+func touch(t *testing.T, path string) {
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, later, later))
+}
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteKeyPair(t, dir, "server")
+
+	serverConfig, err := Server(certFile, keyFile, "")
+	require.NoError(t, err)
+	url := serveTLS(t, serverConfig)
+
+	clientConfig, err := Client(ca.WriteCert(t, dir), "", "")
+	require.NoError(t, err)
+	resp, err := httpClient(clientConfig).Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	// HTTP/2 is still negotiated through the per-handshake config
+	assert.Equal(t, 2, resp.ProtoMajor)
+	assert.Equal(t, uint16(tls.VersionTLS13), resp.TLS.Version)
+}
+
+func TestServer_UntrustedCA(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := tlstest.NewCA(t).WriteKeyPair(t, dir, "server")
+
+	serverConfig, err := Server(certFile, keyFile, "")
+	require.NoError(t, err)
+	url := serveTLS(t, serverConfig)
+
+	clientConfig, err := Client(tlstest.NewCA(t).WriteCert(t, dir), "", "")
+	require.NoError(t, err)
+	_, err = httpClient(clientConfig).Get(url)
+
+	assert.ErrorContains(t, err, "certificate signed by unknown authority")
+}
+
+func TestServer_MutualTLS(t *testing.T) {
+	serverDir, clientDir, otherDir := t.TempDir(), t.TempDir(), t.TempDir()
+	ca := tlstest.NewCA(t)
+	caFile := ca.WriteCert(t, serverDir)
+	certFile, keyFile := ca.WriteKeyPair(t, serverDir, "server")
+
+	serverConfig, err := Server(certFile, keyFile, caFile)
+	require.NoError(t, err)
+	url := serveTLS(t, serverConfig)
+
+	t.Run("client certificate", func(t *testing.T) {
+		clientCert, clientKey := ca.WriteKeyPair(t, clientDir, "client")
+		clientConfig, err := Client(caFile, clientCert, clientKey)
+		require.NoError(t, err)
+
+		resp, err := httpClient(clientConfig).Get(url)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("no client certificate", func(t *testing.T) {
+		clientConfig, err := Client(caFile, "", "")
+		require.NoError(t, err)
+
+		_, err = httpClient(clientConfig).Get(url)
+		assert.Error(t, err)
+	})
+
+	t.Run("client certificate from another CA", func(t *testing.T) {
+		clientCert, clientKey := tlstest.NewCA(t).WriteKeyPair(t, otherDir, "client")
+		clientConfig, err := Client(caFile, clientCert, clientKey)
+		require.NoError(t, err)
+
+		_, err = httpClient(clientConfig).Get(url)
+		assert.Error(t, err)
+	})
+}
+
+func TestServer_ReloadsRotatedKeyPair(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteKeyPair(t, dir, "server")
+
+	serverConfig, err := Server(certFile, keyFile, "")
+	require.NoError(t, err)
+	url := serveTLS(t, serverConfig)
+	clientConfig, err := Client(ca.WriteCert(t, dir), "", "")
+	require.NoError(t, err)
+	client := httpClient(clientConfig)
+
+	assert.Equal(t, "server", peerCommonName(t, client, url))
+
+	// Rotate the key pair in place, as a Kubernetes Secret mount would
+	certPEM, keyPEM := ca.Issue(t, "rotated")
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+	touch(t, certFile)
+	touch(t, keyFile)
+
+	assert.Equal(t, "rotated", peerCommonName(t, client, url))
+}
+
+func TestServer_KeepsKeyPairWhenRotationIsBroken(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteKeyPair(t, dir, "server")
+
+	serverConfig, err := Server(certFile, keyFile, "")
+	require.NoError(t, err)
+	url := serveTLS(t, serverConfig)
+	clientConfig, err := Client(ca.WriteCert(t, dir), "", "")
+	require.NoError(t, err)
+
+	// Only the certificate has been replaced so far, it does not match the key
+	certPEM, _ := ca.Issue(t, "half-rotated")
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0o600))
+	touch(t, certFile)
+
+	assert.Equal(t, "server", peerCommonName(t, httpClient(clientConfig), url))
+}
+
+func TestServer_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t)
+	certFile, keyFile := ca.WriteKeyPair(t, dir, "server")
+	notPEM := filepath.Join(dir, "not-pem")
+	require.NoError(t, os.WriteFile(notPEM, []byte("hello"), 0o600))
+
+	_, err := Server("", keyFile, "")
+	assert.Error(t, err)
+
+	_, err = Server(certFile, filepath.Join(dir, "missing"), "")
+	assert.Error(t, err)
+
+	_, err = Server(keyFile, certFile, "")
+	assert.Error(t, err)
+
+	_, err = Server(certFile, keyFile, notPEM)
+	assert.ErrorContains(t, err, "no PEM certificates")
+}
+
+func TestClient_Invalid(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := Client(filepath.Join(dir, "missing"), "", "")
+	assert.Error(t, err)
+
+	certFile, _ := tlstest.NewCA(t).WriteKeyPair(t, dir, "client")
+	_, err = Client("", certFile, "")
+	assert.Error(t, err)
+}
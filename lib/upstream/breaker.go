@@ -0,0 +1,353 @@
+package upstream
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is matched by errors.Is for every request rejected by an open circuit breaker
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned when a request is rejected without being sent
+// because the upstream's circuit breaker is open
+type CircuitOpenError struct {
+	// Upstream is the name of the upstream whose breaker is open
+	Upstream string
+	// RetryAt is when the breaker will let a probe request through again
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker for %s is open until %s", e.Upstream, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is makes errors.Is(err, ErrCircuitOpen) match
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// State is the state of a circuit breaker
+type State int
+
+const (
+	// StateClosed lets every request through while tracking the failure rate
+	StateClosed State = iota
+	// StateOpen rejects every request until the cool-down has elapsed
+	StateOpen
+	// StateHalfOpen lets a single probe request through to test the upstream
+	StateHalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateOpen:
+		return "open"
+	case StateHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// MarshalText renders the state by name in JSON
+func (s State) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Outcome is the result of a request as reported back to a circuit breaker
+type Outcome int
+
+const (
+	// OutcomeSuccess means the upstream answered properly
+	OutcomeSuccess Outcome = iota
+	// OutcomeFailure means the upstream failed or could not be reached
+	OutcomeFailure
+	// OutcomeIgnored means the request says nothing about the upstream's
+	// health, e.g. because the caller gave up on it
+	OutcomeIgnored
+)
+
+// BreakerSettings controls when a circuit breaker trips and recovers
+type BreakerSettings struct {
+	// Window is the rolling period over which the failure rate is measured
+	Window time.Duration
+	// MinRequests is the number of requests needed in the window before the
+	// breaker may trip, so a single early failure does not open it
+	MinRequests int
+	// FailureRate is the fraction of failed requests, between 0 and 1, at
+	// which the breaker opens
+	FailureRate float64
+	// CoolDown is how long the breaker stays open before probing the upstream
+	CoolDown time.Duration
+}
+
+// DefaultBreakerSettings returns the settings used when none are configured
+func DefaultBreakerSettings() BreakerSettings {
+	return BreakerSettings{
+		Window:      30 * time.Second,
+		MinRequests: 10,
+		FailureRate: 0.5,
+		CoolDown:    15 * time.Second,
+	}
+}
+
+// breakerBuckets is the number of slices the rolling window is divided into
+const breakerBuckets = 10
+
+type bucket struct {
+	start    time.Time
+	requests int
+	failures int
+}
+
+// Breaker is a circuit breaker for a single upstream. It trips from closed to
+// open once the failure rate over a rolling window reaches the threshold,
+// rejects requests while open, and after the cool-down lets one probe through
+// in half-open state: success closes the breaker, failure re-opens it.
+type Breaker struct {
+	name     string
+	settings BreakerSettings
+	now      func() time.Time
+
+	mu        sync.Mutex
+	state     State
+	openedAt  time.Time
+	probing   bool
+	buckets   [breakerBuckets]bucket
+	listeners []func(from, to State)
+	// changes are the state changes made while mu is held, passed to the
+	// listeners once it is released
+	changes [][2]State
+}
+
+// NewBreaker creates a closed circuit breaker for the named upstream
+func NewBreaker(name string, settings BreakerSettings) *Breaker {
+	return &Breaker{
+		name:     name,
+		settings: settings,
+		now:      time.Now,
+	}
+}
+
+// Name returns the name of the upstream the breaker protects
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// OnStateChange registers fn to be called after every state change of the
+// breaker. Calls are made without holding the breaker's lock, in the order
+// the changes happened.
+func (b *Breaker) OnStateChange(fn func(from, to State)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.listeners = append(b.listeners, fn)
+}
+
+// setState moves the breaker to state, queueing a notification for the
+// listeners. It must be called with mu held and followed by unlock.
+func (b *Breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+	b.changes = append(b.changes, [2]State{b.state, state})
+	b.state = state
+}
+
+// unlock releases mu and then notifies the listeners of queued state changes
+func (b *Breaker) unlock() {
+	changes, listeners := b.changes, b.listeners
+	b.changes = nil
+	b.mu.Unlock()
+
+	for _, change := range changes {
+		for _, fn := range listeners {
+			fn(change[0], change[1])
+		}
+	}
+}
+
+// Allow asks permission to send a request. When allowed, the caller must
+// report the outcome through the returned function exactly once.
+func (b *Breaker) Allow() (func(Outcome), error) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	now := b.now()
+	switch b.state {
+	case StateOpen:
+		retryAt := b.openedAt.Add(b.settings.CoolDown)
+		if now.Before(retryAt) {
+			return nil, &CircuitOpenError{Upstream: b.name, RetryAt: retryAt}
+		}
+		b.setState(StateHalfOpen)
+		b.probing = false
+		fallthrough
+	case StateHalfOpen:
+		if b.probing {
+			return nil, &CircuitOpenError{Upstream: b.name, RetryAt: now.Add(b.settings.CoolDown)}
+		}
+		b.probing = true
+		return b.probeDone, nil
+	}
+	return b.record, nil
+}
+
+// probeDone settles the half-open state with the outcome of the probe. An
+// ignored probe leaves the breaker half-open so the next request probes again.
+func (b *Breaker) probeDone(outcome Outcome) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	b.probing = false
+	if b.state != StateHalfOpen {
+		return
+	}
+	switch outcome {
+	case OutcomeSuccess:
+		b.setState(StateClosed)
+		b.buckets = [breakerBuckets]bucket{}
+	case OutcomeFailure:
+		b.setState(StateOpen)
+		b.openedAt = b.now()
+	}
+}
+
+// record adds the outcome of a request made while closed and trips the
+// breaker when the failure rate over the window reaches the threshold
+func (b *Breaker) record(outcome Outcome) {
+	b.mu.Lock()
+	defer b.unlock()
+
+	if b.state != StateClosed || outcome == OutcomeIgnored {
+		return
+	}
+
+	now := b.now()
+	cur := b.bucket(now)
+	cur.requests++
+	if outcome == OutcomeFailure {
+		cur.failures++
+	}
+
+	requests, failures := b.totals(now)
+	if outcome == OutcomeSuccess || requests < b.settings.MinRequests {
+		return
+	}
+	if float64(failures)/float64(requests) >= b.settings.FailureRate {
+		b.setState(StateOpen)
+		b.openedAt = now
+	}
+}
+
+// bucketWidth is the time covered by each bucket of the rolling window
+func (b *Breaker) bucketWidth() time.Duration {
+	return max(b.settings.Window/breakerBuckets, time.Nanosecond)
+}
+
+// bucket returns the bucket for now, recycling it if it holds stale counts
+func (b *Breaker) bucket(now time.Time) *bucket {
+	width := b.bucketWidth()
+	start := now.Truncate(width)
+	cur := &b.buckets[(start.UnixNano()/int64(width))%breakerBuckets]
+	if !cur.start.Equal(start) {
+		*cur = bucket{start: start}
+	}
+	return cur
+}
+
+// totals sums the requests and failures recorded within the window
+func (b *Breaker) totals(now time.Time) (requests, failures int) {
+	cutoff := now.Add(-b.settings.Window)
+	for _, bk := range b.buckets {
+		if bk.start.After(cutoff) {
+			requests += bk.requests
+			failures += bk.failures
+		}
+	}
+	return requests, failures
+}
+
+// BreakerStatus is a point-in-time view of a circuit breaker
+type BreakerStatus struct {
+	Upstream string     `json:"upstream"`
+	State    State      `json:"state"`
+	Requests int        `json:"requests"`
+	Failures int        `json:"failures"`
+	RetryAt  *time.Time `json:"retry_at,omitempty"`
+}
+
+// Status returns the current state and the counts within the window
+func (b *Breaker) Status() BreakerStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	requests, failures := b.totals(now)
+	status := BreakerStatus{
+		Upstream: b.name,
+		State:    b.state,
+		Requests: requests,
+		Failures: failures,
+	}
+	if b.state == StateOpen {
+		retryAt := b.openedAt.Add(b.settings.CoolDown)
+		if now.Before(retryAt) {
+			status.RetryAt = &retryAt
+		} else {
+			// The next request will be let through as a probe
+			status.State = StateHalfOpen
+		}
+	}
+	return status
+}
+
+// State returns the current state of the breaker
+func (b *Breaker) State() State {
+	return b.Status().State
+}
+
+// BreakerTransport is an http.RoundTripper that sends requests through a
+// circuit breaker. Network errors, timeouts and 5xx responses count as
+// failures; requests abandoned by the caller are not counted either way. A
+// timeout must be enforced below the breaker, e.g. with TimeoutTransport: a
+// deadline set through http.Client.Timeout is on the request's context and
+// looks like the caller giving up.
+type BreakerTransport struct {
+	Breaker *Breaker
+	// Base performs the request; http.DefaultTransport when nil
+	Base http.RoundTripper
+}
+
+// NewBreakerTransport wraps base with the circuit breaker
+func NewBreakerTransport(breaker *Breaker, base http.RoundTripper) *BreakerTransport {
+	return &BreakerTransport{Breaker: breaker, Base: base}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *BreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	done, err := t.Breaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	resp, err := base.RoundTrip(req)
+	switch {
+	case err != nil && req.Context().Err() != nil:
+		done(OutcomeIgnored)
+	case err != nil, resp.StatusCode >= 500:
+		done(OutcomeFailure)
+	default:
+		done(OutcomeSuccess)
+	}
+	return resp, err
+}
@@ -0,0 +1,226 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a manually advanced clock for breaker tests
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+var testSettings = BreakerSettings{
+	Window:      10 * time.Second,
+	MinRequests: 4,
+	FailureRate: 0.5,
+	CoolDown:    5 * time.Second,
+}
+
+func newTestBreaker() (*Breaker, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 7, 23, 12, 0, 0, 0, time.UTC)}
+	b := NewBreaker("spacex", testSettings)
+	b.now = clock.Now
+	return b, clock
+}
+
+// report sends one request through the breaker with the given outcome
+func report(t *testing.T, b *Breaker, outcome Outcome) {
+	done, err := b.Allow()
+	require.NoError(t, err)
+	done(outcome)
+}
+
+func TestBreaker_TripsOnFailureRate(t *testing.T) {
+	b, _ := newTestBreaker()
+
+	report(t, b, OutcomeSuccess)
+	report(t, b, OutcomeFailure)
+	report(t, b, OutcomeSuccess)
+	assert.Equal(t, StateClosed, b.State())
+
+	// 2 failures out of 4 requests reaches the 50% threshold
+	report(t, b, OutcomeFailure)
+	assert.Equal(t, StateOpen, b.State())
+
+	_, err := b.Allow()
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	var openErr *CircuitOpenError
+	require.ErrorAs(t, err, &openErr)
+	assert.Equal(t, "spacex", openErr.Upstream)
+}
+
+func TestBreaker_NeedsMinRequests(t *testing.T) {
+	b, _ := newTestBreaker()
+
+	for i := 0; i < testSettings.MinRequests-1; i++ {
+		report(t, b, OutcomeFailure)
+	}
+
+	assert.Equal(t, StateClosed, b.State())
+}
+
+func TestBreaker_ForgetsOutcomesOutsideWindow(t *testing.T) {
+	b, clock := newTestBreaker()
+
+	report(t, b, OutcomeFailure)
+	report(t, b, OutcomeFailure)
+	report(t, b, OutcomeFailure)
+	clock.Advance(testSettings.Window + time.Second)
+	report(t, b, OutcomeFailure)
+
+	status := b.Status()
+	assert.Equal(t, StateClosed, status.State)
+	assert.Equal(t, 1, status.Requests)
+	assert.Equal(t, 1, status.Failures)
+}
+
+func TestBreaker_IgnoredOutcomesDoNotCount(t *testing.T) {
+	b, _ := newTestBreaker()
+
+	for i := 0; i < 10; i++ {
+		report(t, b, OutcomeIgnored)
+	}
+
+	assert.Equal(t, 0, b.Status().Requests)
+}
+
+func tripBreaker(t *testing.T, b *Breaker) {
+	for i := 0; i < testSettings.MinRequests; i++ {
+		report(t, b, OutcomeFailure)
+	}
+	require.Equal(t, StateOpen, b.State())
+}
+
+func TestBreaker_HalfOpenProbeSuccessCloses(t *testing.T) {
+	b, clock := newTestBreaker()
+	tripBreaker(t, b)
+
+	clock.Advance(testSettings.CoolDown)
+	assert.Equal(t, StateHalfOpen, b.State())
+
+	probe, err := b.Allow()
+	require.NoError(t, err)
+
+	// Only one probe at a time
+	_, err = b.Allow()
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+
+	probe(OutcomeSuccess)
+	assert.Equal(t, StateClosed, b.State())
+	assert.Equal(t, 0, b.Status().Requests)
+}
+
+func TestBreaker_HalfOpenProbeFailureReopens(t *testing.T) {
+	b, clock := newTestBreaker()
+	tripBreaker(t, b)
+
+	clock.Advance(testSettings.CoolDown)
+	report(t, b, OutcomeFailure)
+
+	status := b.Status()
+	assert.Equal(t, StateOpen, status.State)
+	require.NotNil(t, status.RetryAt)
+	assert.Equal(t, clock.Now().Add(testSettings.CoolDown), *status.RetryAt)
+}
+
+func TestBreaker_HalfOpenProbeIgnoredStaysHalfOpen(t *testing.T) {
+	b, clock := newTestBreaker()
+	tripBreaker(t, b)
+
+	clock.Advance(testSettings.CoolDown)
+	report(t, b, OutcomeIgnored)
+
+	assert.Equal(t, StateHalfOpen, b.State())
+	_, err := b.Allow()
+	assert.NoError(t, err)
+}
+
+func TestBreaker_OnStateChange(t *testing.T) {
+	b, clock := newTestBreaker()
+	var changes []string
+	b.OnStateChange(func(from, to State) {
+		// Listeners run without the lock held, so they may query the breaker
+		assert.Equal(t, to, b.Status().State)
+		changes = append(changes, from.String()+"->"+to.String())
+	})
+
+	tripBreaker(t, b)
+	clock.Advance(testSettings.CoolDown)
+	report(t, b, OutcomeFailure)
+	clock.Advance(testSettings.CoolDown)
+	report(t, b, OutcomeSuccess)
+
+	assert.Equal(t, []string{
+		"closed->open",
+		"open->half-open",
+		"half-open->open",
+		"open->half-open",
+		"half-open->closed",
+	}, changes)
+}
+
+func TestBreakerTransport(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	b, _ := newTestBreaker()
+	client := &http.Client{Transport: NewBreakerTransport(b, nil)}
+
+	for i := 0; i < testSettings.MinRequests; i++ {
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// The breaker is now open and fails fast without reaching the server
+	_, err := client.Get(server.URL)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(testSettings.MinRequests), calls.Load())
+}
+
+func TestBreakerTransport_CallerCancellationIgnored(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	b, _ := newTestBreaker()
+	client := &http.Client{Transport: NewBreakerTransport(b, nil)}
+
+	for i := 0; i < testSettings.MinRequests; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		_, err := client.Do(req)
+		cancel()
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	}
+
+	assert.Equal(t, StateClosed, b.State())
+}
+
+func TestState_String(t *testing.T) {
+	assert.Equal(t, "closed", StateClosed.String())
+	assert.Equal(t, "open", StateOpen.String())
+	assert.Equal(t, "half-open", StateHalfOpen.String())
+}
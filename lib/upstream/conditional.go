@@ -0,0 +1,167 @@
+package upstream
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// maxStoredBody is the largest response body kept for revalidation
+const maxStoredBody = 1 << 20
+
+// storedResponse is a response kept for conditional revalidation
+type storedResponse struct {
+	key          string
+	status       int
+	header       http.Header
+	body         []byte
+	etag         string
+	lastModified string
+}
+
+// ConditionalTransport is an http.RoundTripper that remembers the ETag and
+// Last-Modified validators of GET responses, sends them back as
+// If-None-Match and If-Modified-Since, and turns a 304 Not Modified into the
+// stored response, so unchanged data is not transferred again
+type ConditionalTransport struct {
+	// Base performs the request; http.DefaultTransport when nil
+	Base       http.RoundTripper
+	maxEntries int
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+// NewConditionalTransport wraps base, remembering up to maxEntries responses
+func NewConditionalTransport(base http.RoundTripper, maxEntries int) *ConditionalTransport {
+	return &ConditionalTransport{
+		Base:       base,
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+func (t *ConditionalTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *ConditionalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return t.base().RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	stored := t.lookup(key)
+	if stored != nil {
+		// RoundTrippers must not modify the caller's request
+		req = req.Clone(req.Context())
+		if stored.etag != "" {
+			req.Header.Set("If-None-Match", stored.etag)
+		}
+		if stored.lastModified != "" {
+			req.Header.Set("If-Modified-Since", stored.lastModified)
+		}
+	}
+
+	resp, err := t.base().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified && stored != nil {
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		return stored.response(req), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || !cacheable(resp) {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxStoredBody+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxStoredBody {
+		// Too large to keep; hand back what was read followed by the rest
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	t.store(&storedResponse{
+		key:          key,
+		status:       resp.StatusCode,
+		header:       resp.Header.Clone(),
+		body:         body,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+	})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// cacheable reports whether resp carries validators and may be stored
+func cacheable(resp *http.Response) bool {
+	if resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "" {
+		return false
+	}
+	return !strings.Contains(strings.ToLower(resp.Header.Get("Cache-Control")), "no-store")
+}
+
+// response rebuilds the stored response for req
+func (s *storedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(s.status),
+		StatusCode:    s.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        s.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(s.body)),
+		ContentLength: int64(len(s.body)),
+		Request:       req,
+	}
+}
+
+func (t *ConditionalTransport) lookup(key string) *storedResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	el, ok := t.items[key]
+	if !ok {
+		return nil
+	}
+	t.ll.MoveToFront(el)
+	return el.Value.(*storedResponse)
+}
+
+func (t *ConditionalTransport) store(s *storedResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if el, ok := t.items[s.key]; ok {
+		el.Value = s
+		t.ll.MoveToFront(el)
+		return
+	}
+	t.items[s.key] = t.ll.PushFront(s)
+	for t.maxEntries > 0 && t.ll.Len() > t.maxEntries {
+		oldest := t.ll.Back()
+		t.ll.Remove(oldest)
+		delete(t.items, oldest.Value.(*storedResponse).key)
+	}
+}
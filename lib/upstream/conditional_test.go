@@ -0,0 +1,105 @@
+package upstream
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// validatingServer serves body with the given validator headers and answers
+// 304 to matching conditional requests, recording the status of each reply
+func validatingServer(header http.Header, body string) (*httptest.Server, *[]int) {
+	var statuses []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		etag := header.Get("ETag")
+		lastModified := header.Get("Last-Modified")
+		if (etag != "" && r.Header.Get("If-None-Match") == etag) ||
+			(etag == "" && lastModified != "" && r.Header.Get("If-Modified-Since") == lastModified) {
+			statuses = append(statuses, http.StatusNotModified)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		statuses = append(statuses, http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	return server, &statuses
+}
+
+func getBody(t *testing.T, client *http.Client, url string) (int, string) {
+	resp, err := client.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+func TestConditionalTransport_ETag(t *testing.T) {
+	server, statuses := validatingServer(http.Header{"Etag": {`W/"53-abc"`}}, `{"text":"42"}`)
+	defer server.Close()
+	client := &http.Client{Transport: NewConditionalTransport(nil, 10)}
+
+	for i := 0; i < 3; i++ {
+		status, body := getBody(t, client, server.URL+"/random/math")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, `{"text":"42"}`, body)
+	}
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusNotModified, http.StatusNotModified}, *statuses)
+}
+
+func TestConditionalTransport_LastModified(t *testing.T) {
+	server, statuses := validatingServer(http.Header{"Last-Modified": {"Wed, 23 Jul 2025 21:13:07 GMT"}}, "rockets")
+	defer server.Close()
+	client := &http.Client{Transport: NewConditionalTransport(nil, 10)}
+
+	getBody(t, client, server.URL)
+	status, body := getBody(t, client, server.URL)
+
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "rockets", body)
+	assert.Equal(t, []int{http.StatusOK, http.StatusNotModified}, *statuses)
+}
+
+func TestConditionalTransport_WithoutValidators(t *testing.T) {
+	server, statuses := validatingServer(http.Header{}, "plain")
+	defer server.Close()
+	client := &http.Client{Transport: NewConditionalTransport(nil, 10)}
+
+	getBody(t, client, server.URL)
+	getBody(t, client, server.URL)
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK}, *statuses)
+}
+
+func TestConditionalTransport_NoStore(t *testing.T) {
+	server, statuses := validatingServer(http.Header{"Etag": {`"v1"`}, "Cache-Control": {"private, no-store"}}, "secret")
+	defer server.Close()
+	client := &http.Client{Transport: NewConditionalTransport(nil, 10)}
+
+	getBody(t, client, server.URL)
+	getBody(t, client, server.URL)
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK}, *statuses)
+}
+
+func TestConditionalTransport_KeyedByURL(t *testing.T) {
+	server, statuses := validatingServer(http.Header{"Etag": {`"v1"`}}, "data")
+	defer server.Close()
+	client := &http.Client{Transport: NewConditionalTransport(nil, 1)}
+
+	getBody(t, client, server.URL+"/a")
+	// Storing b evicts a, so a is fetched in full again
+	getBody(t, client, server.URL+"/b")
+	getBody(t, client, server.URL+"/a")
+	getBody(t, client, server.URL+"/a")
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusNotModified}, *statuses)
+}
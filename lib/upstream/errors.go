@@ -0,0 +1,112 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// Error kinds, matched with errors.Is against any *Error
+var (
+	// ErrNotFound means the upstream does not know the requested resource
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited means the upstream refused the request because of rate limiting
+	ErrRateLimited = errors.New("rate limited")
+	// ErrUnavailable means the upstream could not be reached or failed to answer
+	ErrUnavailable = errors.New("unavailable")
+	// ErrBadPayload means the upstream answered with a body that could not be decoded
+	ErrBadPayload = errors.New("bad payload")
+	// ErrTimeout means the upstream did not answer before the deadline
+	ErrTimeout = errors.New("timeout")
+)
+
+// Error describes a failed call to an upstream API
+type Error struct {
+	// Upstream is the name of the upstream that failed
+	Upstream string
+	// Kind is one of ErrNotFound, ErrRateLimited, ErrUnavailable,
+	// ErrBadPayload or ErrTimeout
+	Kind error
+	// StatusCode is the HTTP status the upstream answered with, if any
+	StatusCode int
+	// Err is the underlying cause, if any
+	Err error
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s upstream: %v", e.Upstream, e.Kind)
+	if e.StatusCode != 0 {
+		msg += fmt.Sprintf(" (HTTP %d)", e.StatusCode)
+	}
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap makes errors.Is match both the kind and the underlying cause
+func (e *Error) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// Retryable reports whether the same call may succeed when tried again later
+func (e *Error) Retryable() bool {
+	switch e.Kind {
+	case ErrRateLimited, ErrTimeout:
+		return true
+	case ErrUnavailable:
+		return e.StatusCode == 0 || e.StatusCode >= 500
+	}
+	return false
+}
+
+// CheckStatus returns nil for a 2xx response from the named upstream and an
+// *Error of the matching kind otherwise
+func CheckStatus(name string, resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	kind := ErrUnavailable
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		kind = ErrNotFound
+	case http.StatusTooManyRequests:
+		kind = ErrRateLimited
+	case http.StatusGatewayTimeout:
+		kind = ErrTimeout
+	}
+	return &Error{Upstream: name, Kind: kind, StatusCode: resp.StatusCode}
+}
+
+// Classify turns an error returned by the HTTP client for a call to the named
+// upstream into an *Error. Errors that are already classified, and
+// cancellations by the caller, are returned unchanged.
+func Classify(name string, err error) error {
+	var upstreamErr *Error
+	if err == nil || errors.As(err, &upstreamErr) {
+		return err
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &Error{Upstream: name, Kind: ErrTimeout, Err: err}
+	}
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return &Error{Upstream: name, Kind: ErrTimeout, Err: err}
+	}
+	return &Error{Upstream: name, Kind: ErrUnavailable, Err: err}
+}
+
+// BadPayload wraps a decoding error for a response from the named upstream
+func BadPayload(name string, err error) error {
+	return &Error{Upstream: name, Kind: ErrBadPayload, Err: err}
+}
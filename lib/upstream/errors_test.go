@@ -0,0 +1,78 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckStatus(t *testing.T) {
+	tests := []struct {
+		status    int
+		kind      error
+		retryable bool
+	}{
+		{http.StatusNotFound, ErrNotFound, false},
+		{http.StatusTooManyRequests, ErrRateLimited, true},
+		{http.StatusGatewayTimeout, ErrTimeout, true},
+		{http.StatusBadGateway, ErrUnavailable, true},
+		{http.StatusServiceUnavailable, ErrUnavailable, true},
+		{http.StatusForbidden, ErrUnavailable, false},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			err := CheckStatus("spacex", &http.Response{StatusCode: tt.status})
+
+			var upstreamErr *Error
+			assert.ErrorAs(t, err, &upstreamErr)
+			assert.ErrorIs(t, err, tt.kind)
+			assert.Equal(t, "spacex", upstreamErr.Upstream)
+			assert.Equal(t, tt.status, upstreamErr.StatusCode)
+			assert.Equal(t, tt.retryable, upstreamErr.Retryable())
+		})
+	}
+
+	assert.NoError(t, CheckStatus("spacex", &http.Response{StatusCode: http.StatusOK}))
+	assert.NoError(t, CheckStatus("spacex", &http.Response{StatusCode: http.StatusNoContent}))
+}
+
+func TestClassify(t *testing.T) {
+	circuitOpen := &url.Error{Op: "Get", URL: "http://numbersapi.com", Err: &CircuitOpenError{Upstream: "numbers", RetryAt: time.Now()}}
+	deadline := &url.Error{Op: "Get", URL: "http://numbersapi.com", Err: context.DeadlineExceeded}
+	refused := &url.Error{Op: "Get", URL: "http://numbersapi.com", Err: errors.New("connection refused")}
+
+	err := Classify("numbers", circuitOpen)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+
+	err = Classify("numbers", deadline)
+	assert.ErrorIs(t, err, ErrTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	err = Classify("numbers", refused)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.EqualError(t, err, `numbers upstream: unavailable: Get "http://numbersapi.com": connection refused`)
+
+	// Cancellation by the caller is not an upstream failure
+	assert.Equal(t, context.Canceled, Classify("numbers", context.Canceled))
+
+	// Already classified errors are kept as they are
+	wrapped := fmt.Errorf("wrapped: %w", &Error{Upstream: "spacex", Kind: ErrNotFound, StatusCode: http.StatusNotFound})
+	assert.Equal(t, wrapped, Classify("numbers", wrapped))
+
+	assert.NoError(t, Classify("numbers", nil))
+}
+
+func TestBadPayload(t *testing.T) {
+	err := BadPayload("nasa", errors.New("unexpected EOF"))
+
+	assert.ErrorIs(t, err, ErrBadPayload)
+	assert.False(t, err.(*Error).Retryable())
+	assert.EqualError(t, err, "nasa upstream: bad payload: unexpected EOF")
+}
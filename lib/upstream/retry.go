@@ -0,0 +1,189 @@
+package upstream
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// RetryPolicy controls how failed idempotent requests are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// Values of 1 or less disable retries.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry. It doubles with every
+	// further attempt and is fully jittered.
+	BaseDelay time.Duration
+	// MaxDelay caps a single backoff. A Retry-After asking for longer than
+	// MaxDelay is not honored and the response is returned as is.
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used when none is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+	}
+}
+
+// RetryTransport is an http.RoundTripper that retries idempotent requests
+// (GET, HEAD and OPTIONS, or any request with an Idempotency-Key header) on
+// network errors, 429 and 5xx responses with exponential backoff and jitter,
+// honoring Retry-After
+type RetryTransport struct {
+	// Name identifies the upstream in log lines, e.g. "spacex"
+	Name string
+	// Base performs each attempt; http.DefaultTransport when nil
+	Base http.RoundTripper
+	// Policy controls the number of attempts and the backoff
+	Policy RetryPolicy
+}
+
+// NewRetryTransport wraps base with the retry policy for the named upstream
+func NewRetryTransport(name string, base http.RoundTripper, policy RetryPolicy) *RetryTransport {
+	return &RetryTransport{Name: name, Base: base, Policy: policy}
+}
+
+func (t *RetryTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req) || t.Policy.MaxAttempts <= 1 {
+		return t.base().RoundTrip(req)
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := t.base().RoundTrip(req)
+		if attempt >= t.Policy.MaxAttempts || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		delay, ok := t.backoff(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+
+		event := log.Warn().
+			Str("upstream", t.Name).
+			Str("method", req.Method).
+			Str("host", req.URL.Host).
+			Str("path", req.URL.Path).
+			Int("attempt", attempt).
+			Int("max_attempts", t.Policy.MaxAttempts).
+			Dur("delay", delay)
+		if err != nil {
+			event = event.Err(err)
+		} else {
+			event = event.Int("status", resp.StatusCode)
+			// Drain the body so the connection can be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		event.Msg("Retrying upstream request")
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rewind returns a copy of req with a fresh body for the next attempt
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
+
+// backoff returns how long to wait before the next attempt. Retry-After takes
+// precedence over the exponential backoff; false means the server asked us to
+// wait longer than the policy allows.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if d, ok := retryAfter(resp); ok {
+			return d, d <= t.Policy.MaxDelay
+		}
+	}
+
+	ceiling := t.Policy.BaseDelay << (attempt - 1)
+	if ceiling <= 0 || ceiling > t.Policy.MaxDelay {
+		ceiling = t.Policy.MaxDelay
+	}
+	if ceiling <= 0 {
+		return 0, true
+	}
+	return rand.N(ceiling) + 1, true
+}
+
+// isIdempotent reports whether req may be sent more than once. Like
+// net/http, a request carrying an Idempotency-Key header is treated as
+// idempotent whatever its method; a nil header value marks the request
+// without sending the header.
+func isIdempotent(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	_, ok := req.Header["Idempotency-Key"]
+	return ok
+}
+
+// shouldRetry reports whether the outcome of an attempt is worth retrying
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// Our own caller giving up is not a transient failure
+		return req.Context().Err() == nil
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as an HTTP date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done, whichever comes first
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,211 @@
+package upstream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastPolicy keeps the backoff short so the tests run quickly
+var fastPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+// failingServer answers with status for the first failures requests and 200 afterwards
+func failingServer(failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	return server, &calls
+}
+
+func retryClient(policy RetryPolicy) *http.Client {
+	return &http.Client{Transport: NewRetryTransport("test", nil, policy)}
+}
+
+func TestRetryTransport_RecoversFromTransientFailures(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusTooManyRequests} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			server, calls := failingServer(2, status, nil)
+			defer server.Close()
+
+			resp, err := retryClient(fastPolicy).Get(server.URL)
+
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, int32(3), calls.Load())
+		})
+	}
+}
+
+func TestRetryTransport_GivesUpAfterMaxAttempts(t *testing.T) {
+	server, calls := failingServer(10, http.StatusInternalServerError, nil)
+	defer server.Close()
+
+	resp, err := retryClient(fastPolicy).Get(server.URL)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestRetryTransport_DoesNotRetryClientErrors(t *testing.T) {
+	server, calls := failingServer(10, http.StatusNotFound, nil)
+	defer server.Close()
+
+	resp, err := retryClient(fastPolicy).Get(server.URL)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_DoesNotRetryNonIdempotentRequests(t *testing.T) {
+	server, calls := failingServer(10, http.StatusServiceUnavailable, nil)
+	defer server.Close()
+
+	resp, err := retryClient(fastPolicy).Post(server.URL, "application/json", strings.NewReader("{}"))
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_RetriesRequestsWithIdempotencyKey(t *testing.T) {
+	var calls atomic.Int32
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"query": {}}`))
+	req.Header["Idempotency-Key"] = nil
+	resp, err := retryClient(fastPolicy).Do(req)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+	// The body is sent again with every attempt
+	assert.Equal(t, []string{`{"query": {}}`, `{"query": {}}`, `{"query": {}}`}, bodies)
+}
+
+func TestRetryTransport_RetriesNetworkErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			// Drop the connection without answering
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	resp, err := retryClient(fastPolicy).Get(server.URL)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestRetryTransport_HonorsRetryAfter(t *testing.T) {
+	server, calls := failingServer(1, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+	defer server.Close()
+
+	policy := RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, MaxDelay: 2 * time.Second}
+	start := time.Now()
+	resp, err := retryClient(policy).Get(server.URL)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRetryTransport_RetryAfterBeyondMaxDelay(t *testing.T) {
+	server, calls := failingServer(1, http.StatusTooManyRequests, http.Header{"Retry-After": {"120"}})
+	defer server.Close()
+
+	resp, err := retryClient(fastPolicy).Get(server.URL)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_StopsWhenContextDone(t *testing.T) {
+	server, calls := failingServer(10, http.StatusServiceUnavailable, nil)
+	defer server.Close()
+
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Second}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	_, err := retryClient(policy).Do(req)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	rt := NewRetryTransport("test", nil, RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second})
+
+	for attempt := 1; attempt <= 8; attempt++ {
+		delay, ok := rt.backoff(attempt, nil)
+		ceiling := min(100*time.Millisecond<<(attempt-1), time.Second)
+		assert.True(t, ok)
+		assert.Greater(t, delay, time.Duration(0))
+		assert.LessOrEqual(t, delay, ceiling)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{}}
+	_, ok := retryAfter(resp)
+	assert.False(t, ok)
+
+	resp.Header.Set("Retry-After", "3")
+	d, ok := retryAfter(resp)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	d, ok = retryAfter(resp)
+	assert.True(t, ok)
+	assert.InDelta(t, time.Hour.Seconds(), d.Seconds(), 2)
+
+	resp.Header.Set("Retry-After", "soon")
+	_, ok = retryAfter(resp)
+	assert.False(t, ok)
+}
@@ -0,0 +1,59 @@
+package upstream
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// TimeoutTransport is an http.RoundTripper that bounds each request, all of
+// its attempts and the reading of the response body by Timeout. It takes the
+// place of http.Client.Timeout, which sets its deadline on the request before
+// any transport sees it: placed below a BreakerTransport, the deadline is the
+// upstream's fault and counts as a failure, while a deadline or cancellation
+// of the caller's context is still ignored.
+type TimeoutTransport struct {
+	// Timeout of zero or less leaves requests unbounded
+	Timeout time.Duration
+	// Base performs the request; http.DefaultTransport when nil
+	Base http.RoundTripper
+}
+
+// NewTimeoutTransport wraps base so that every request times out after timeout
+func NewTimeoutTransport(base http.RoundTripper, timeout time.Duration) *TimeoutTransport {
+	return &TimeoutTransport{Timeout: timeout, Base: base}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *TimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if t.Timeout <= 0 {
+		return base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+	resp, err := base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// The deadline keeps applying until the caller is done with the body
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose releases the request's timeout once its body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,65 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hangingServer answers only once the request is abandoned
+func hangingServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestTimeoutTransport(t *testing.T) {
+	client := &http.Client{Transport: NewTimeoutTransport(nil, 20*time.Millisecond)}
+
+	start := time.Now()
+	_, err := client.Get(hangingServer(t).URL)
+
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestTimeoutTransport_BodyReadableUntilClosed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTimeoutTransport(nil, time.Second)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(body))
+	assert.NoError(t, resp.Body.Close())
+}
+
+func TestBreakerTransport_UpstreamTimeoutCountsAsFailure(t *testing.T) {
+	server := hangingServer(t)
+
+	b, _ := newTestBreaker()
+	client := &http.Client{Transport: NewBreakerTransport(b, NewTimeoutTransport(nil, 10*time.Millisecond))}
+
+	for i := 0; i < testSettings.MinRequests; i++ {
+		_, err := client.Get(server.URL)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	}
+
+	assert.Equal(t, StateOpen, b.State())
+	_, err := client.Get(server.URL)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
@@ -0,0 +1,110 @@
+package upstream
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Transport is an http.RoundTripper that logs every outbound request to an
+// upstream API with a consistent set of structured fields: upstream, method,
+// host, path, status, latency and bytes. The query string is never logged
+// because it may carry credentials such as API keys.
+type Transport struct {
+	// Name identifies the upstream in log lines, e.g. "spacex"
+	Name string
+	// Base performs the actual request; http.DefaultTransport when nil
+	Base http.RoundTripper
+}
+
+// NewTransport wraps base with outbound request logging for the named upstream
+func NewTransport(name string, base http.RoundTripper) *Transport {
+	return &Transport{Name: name, Base: base}
+}
+
+// Instrument returns a copy of client whose transport logs requests for the
+// named upstream. The original client is left untouched.
+func Instrument(name string, client *http.Client) *http.Client {
+	c := *client
+	c.Transport = NewTransport(name, client.Transport)
+	return &c
+}
+
+func (t *Transport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip implements http.RoundTripper. Successful requests are logged once
+// the response body is closed so that latency and bytes cover the full body.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	resp, err := t.base().RoundTrip(req)
+	if err != nil {
+		log.Error().
+			Str("upstream", t.Name).
+			Str("method", req.Method).
+			Str("host", req.URL.Host).
+			Str("path", req.URL.Path).
+			Dur("latency", time.Since(start)).
+			Err(err).
+			Msg("Outbound request failed")
+		return nil, err
+	}
+
+	// Log X-prefixed headers
+	for header, values := range resp.Header {
+		if len(header) > 0 && (header[0] == 'X' || header[0] == 'x') {
+			log.Info().
+				Str("upstream", t.Name).
+				Str("header", header).
+				Strs("values", values).
+				Msg("X-Header found")
+		}
+	}
+
+	resp.Body = &countingBody{
+		ReadCloser: resp.Body,
+		onClose: func(bytes int64) {
+			log.Info().
+				Str("upstream", t.Name).
+				Str("method", req.Method).
+				Str("host", req.URL.Host).
+				Str("path", req.URL.Path).
+				Int("status", resp.StatusCode).
+				Dur("latency", time.Since(start)).
+				Int64("bytes", bytes).
+				Msg("Outbound")
+		},
+	}
+	return resp, nil
+}
+
+// countingBody counts the bytes read from a response body and reports the
+// total exactly once when the body is closed
+type countingBody struct {
+	io.ReadCloser
+	bytes   int64
+	once    sync.Once
+	onClose func(bytes int64)
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.bytes += int64(n)
+	return n, err
+}
+
+func (b *countingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.onClose(b.bytes)
+	})
+	return err
+}
@@ -0,0 +1,103 @@
+package upstream
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureLogs redirects the global logger to a buffer for the rest of the test
+func captureLogs(t *testing.T) *bytes.Buffer {
+	var buf bytes.Buffer
+	orig := log.Logger
+	log.Logger = zerolog.New(&buf)
+	t.Cleanup(func() {
+		log.Logger = orig
+	})
+	return &buf
+}
+
+// logLines decodes the JSON log lines written to buf
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestTransport_LogsCompletedRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "abc")
+		w.WriteHeader(http.StatusTeapot)
+		w.Write([]byte("hello world"))
+	}))
+	defer server.Close()
+	buf := captureLogs(t)
+
+	client := Instrument("spacex", &http.Client{Timeout: time.Second})
+	resp, err := client.Get(server.URL + "/v4/rockets?api_key=secret")
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "hello world", string(body))
+
+	lines := logLines(t, buf)
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, "X-Header found", lines[0]["message"])
+	assert.Equal(t, "X-Request-Id", lines[0]["header"])
+
+	entry := lines[1]
+	assert.Equal(t, "Outbound", entry["message"])
+	assert.Equal(t, "spacex", entry["upstream"])
+	assert.Equal(t, "GET", entry["method"])
+	assert.Equal(t, strings.TrimPrefix(server.URL, "http://"), entry["host"])
+	assert.Equal(t, "/v4/rockets", entry["path"])
+	assert.Equal(t, float64(http.StatusTeapot), entry["status"])
+	assert.Equal(t, float64(len("hello world")), entry["bytes"])
+	assert.Contains(t, entry, "latency")
+	assert.NotContains(t, buf.String(), "secret")
+}
+
+func TestTransport_LogsFailedRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+	buf := captureLogs(t)
+
+	client := Instrument("numbers", &http.Client{Timeout: time.Second})
+	_, err := client.Get(server.URL + "/random/math")
+	require.Error(t, err)
+
+	lines := logLines(t, buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "Outbound request failed", lines[0]["message"])
+	assert.Equal(t, "numbers", lines[0]["upstream"])
+	assert.Equal(t, "/random/math", lines[0]["path"])
+	assert.Contains(t, lines[0], "error")
+}
+
+func TestInstrument_LeavesOriginalClientUntouched(t *testing.T) {
+	base := &http.Client{Timeout: time.Minute}
+
+	client := Instrument("nasa", base)
+
+	assert.Nil(t, base.Transport)
+	assert.Equal(t, time.Minute, client.Timeout)
+	assert.IsType(t, &Transport{}, client.Transport)
+}
@@ -1,12 +1,24 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"outerspace-go/lib"
+	"outerspace-go/lib/cache"
+	"outerspace-go/lib/config"
 	"outerspace-go/lib/grpc"
 	"outerspace-go/lib/logger"
+	"outerspace-go/lib/secret"
+	"outerspace-go/lib/server"
+	"outerspace-go/lib/tlsconfig"
+	"outerspace-go/lib/upstream"
 )
 
 var (
@@ -20,28 +32,150 @@ func main() {
 
 	log.Printf("outerspace-go version %s (built at %s)", Version, BuildTime)
 
-	spaceClient := lib.NewSpaceXClient()
-	numbersClient := lib.NewNumbersClient()
-	nasaClient := lib.NewNASAClient()
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if err := logger.SetLevel(cfg.LogLevel); err != nil {
+		log.Fatalf("Invalid log level: %v", err)
+	}
+
+	// One circuit breaker per upstream so an outage of one API does not
+	// affect calls to the others
+	spaceBreaker := upstream.NewBreaker(lib.SpaceXUpstream, cfg.BreakerSettings())
+	numbersBreaker := upstream.NewBreaker(lib.NumbersUpstream, cfg.BreakerSettings())
+	nasaBreaker := upstream.NewBreaker(lib.NASAUpstream, cfg.BreakerSettings())
+
+	upstreamOpts := []lib.ClientOption{lib.WithTimeout(cfg.UpstreamTimeout), lib.WithRetryPolicy(cfg.RetryPolicy())}
+	spaceClient := lib.NewSpaceXClient(append(upstreamOpts, lib.WithBaseURL(cfg.SpaceXBaseURL), lib.WithCircuitBreaker(spaceBreaker))...)
+	numbersClient := lib.NewNumbersClient(append(upstreamOpts, lib.WithBaseURL(cfg.NumbersBaseURL), lib.WithCircuitBreaker(numbersBreaker))...)
+	nasaOpts := append(upstreamOpts, lib.WithBaseURL(cfg.NASABaseURL), lib.WithCircuitBreaker(nasaBreaker))
+	switch {
+	case cfg.NASAAPIKeyFile != "":
+		keyFile, err := secret.NewFile(cfg.NASAAPIKeyFile)
+		if err != nil {
+			log.Fatalf("Invalid NASA API key file: %v", err)
+		}
+		log.Printf("Using NASA API key from %s", keyFile.Path())
+		nasaOpts = append(nasaOpts, lib.WithAPIKeyFunc(keyFile.Value))
+	case cfg.NASAAPIKey != "":
+		log.Printf("Using NASA API key from NASA_API_KEY")
+		nasaOpts = append(nasaOpts, lib.WithAPIKey(cfg.NASAAPIKey))
+	default:
+		log.Printf("No NASA API key configured, falling back to the rate-limited %s", lib.DemoAPIKey)
+	}
+	nasaClient := lib.NewNASAClient(nasaOpts...)
+
+	// Serve the REST and gRPC APIs from an in-memory cache in front of the upstreams
+	responseCache := cache.New(cfg.CacheMaxEntries)
+	cachedSpaceClient := cache.NewSpaceXClient(spaceClient, responseCache, cache.SpaceXTTLs{
+		Rockets:      cache.TTL{Fresh: cfg.CacheRocketsTTL, Stale: cfg.CacheStaleTTL},
+		Fleet:        cache.TTL{Fresh: cfg.CacheRocketsTTL, Stale: cfg.CacheStaleTTL},
+		LatestLaunch: cache.TTL{Fresh: cfg.CacheLaunchTTL, Stale: cfg.CacheStaleTTL},
+		Launches:     cache.TTL{Fresh: cfg.CacheLaunchTTL, Stale: cfg.CacheStaleTTL},
+	})
+	cachedNumbersClient := cache.NewNumbersClient(numbersClient, responseCache, cache.TTL{Fresh: cfg.CacheMathFactTTL, Stale: cfg.CacheStaleTTL})
+	cachedNASAClient := cache.NewNASAClient(nasaClient, responseCache, cache.TTL{Fresh: cfg.CacheAPODTTL, Stale: cfg.CacheStaleTTL})
 
 	// Define routes
-	http.HandleFunc("/", lib.HandleRoot())
-	http.HandleFunc("/api/latest-launch", lib.HandleLatestLaunch(spaceClient))
-	http.HandleFunc("/api/rocket", lib.HandleRocket(spaceClient))
-	http.HandleFunc("/api/rockets", lib.HandleListRockets(spaceClient))
-	http.HandleFunc("/api/numbers", lib.HandleNumbers(numbersClient))
-	http.HandleFunc("/api/nasa", lib.HandleNASA(nasaClient))
-
-	// Start HTTP server in a goroutine
-	go func() {
-		log.Printf("Starting HTTP server on :8080")
-		if err := http.ListenAndServe(":8080", nil); err != nil {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", lib.HandleRoot())
+	mux.HandleFunc("/api/latest-launch", lib.HandleLatestLaunch(cachedSpaceClient))
+	mux.HandleFunc("/api/next-launch", lib.HandleNextLaunch(cachedSpaceClient))
+	mux.HandleFunc("/api/upcoming-launches", lib.HandleUpcomingLaunches(cachedSpaceClient))
+	mux.HandleFunc("/api/launch", lib.HandleLaunch(cachedSpaceClient))
+	mux.HandleFunc("/api/launches", lib.HandleListLaunches(cachedSpaceClient))
+	mux.HandleFunc("/api/rocket", lib.HandleRocket(cachedSpaceClient))
+	mux.HandleFunc("/api/rockets", lib.HandleListRockets(cachedSpaceClient))
+	mux.HandleFunc("/api/capsules", lib.HandleListCapsules(cachedSpaceClient))
+	mux.HandleFunc("/api/capsule", lib.HandleCapsule(cachedSpaceClient))
+	mux.HandleFunc("/api/cores", lib.HandleListCores(cachedSpaceClient))
+	mux.HandleFunc("/api/crew", lib.HandleListCrew(cachedSpaceClient))
+	mux.HandleFunc("/api/launchpads", lib.HandleListLaunchpads(cachedSpaceClient))
+	mux.HandleFunc("/api/launchpad", lib.HandleLaunchpad(cachedSpaceClient))
+	mux.HandleFunc("/api/landpads", lib.HandleListLandpads(cachedSpaceClient))
+	mux.HandleFunc("/api/numbers", lib.HandleNumbers(cachedNumbersClient))
+	mux.HandleFunc("/api/nasa", lib.HandleNASA(cachedNASAClient))
+	mux.HandleFunc("/api/status", lib.HandleStatus(spaceBreaker, numbersBreaker, nasaBreaker))
+
+	// Count gRPC calls and their latency, served with the runtime stats
+	grpcMetrics := grpc.NewMetrics()
+	expvar.Publish("grpc", grpcMetrics)
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	httpServer := &http.Server{Handler: mux}
+	var httpLis, httpsLis net.Listener
+	if cfg.HTTPAddr != "" {
+		if httpLis, err = net.Listen("tcp", cfg.HTTPAddr); err != nil {
 			log.Fatal(err)
 		}
-	}()
-
-	// Start gRPC server
-	if err := grpc.StartServer(spaceClient, numbersClient, ":50053"); err != nil {
+	}
+	grpcLis, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
 		log.Fatal(err)
 	}
+
+	// Closed by srv.Run once shutdown starts
+	stopping := make(chan struct{})
+
+	// Report per-upstream health from the circuit breakers over grpc.health.v1
+	health := grpc.NewHealth(spaceBreaker, numbersBreaker, nasaBreaker)
+	grpcOpts := []grpc.ServerOption{
+		grpc.WithHealth(health),
+		grpc.WithWatchInterval(cfg.LaunchWatchInterval),
+		// Streams poll SpaceX itself so that a change is seen within one
+		// interval rather than once the cached launch expires
+		grpc.WithWatchClient(spaceClient),
+		// End those streams on shutdown so they do not hold up the drain
+		grpc.WithShutdown(stopping),
+		grpc.WithMetrics(grpcMetrics.Observe),
+	}
+	if cfg.GRPCReflection {
+		grpcOpts = append(grpcOpts, grpc.WithReflection())
+	}
+
+	// Serve HTTPS and gRPC over TLS, re-reading the key pair and client CAs
+	// whenever they are rotated
+	if cfg.TLSEnabled() {
+		tlsConfig, err := tlsconfig.Server(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.TLSClientCAFile)
+		if err != nil {
+			log.Fatalf("Invalid TLS configuration: %v", err)
+		}
+		if httpsLis, err = net.Listen("tcp", cfg.HTTPSAddr); err != nil {
+			log.Fatal(err)
+		}
+		httpServer.TLSConfig = tlsConfig
+		grpcOpts = append(grpcOpts, grpc.WithTLS(tlsConfig))
+		log.Printf("TLS enabled (client certificates required: %t)", cfg.TLSClientCAFile != "")
+	}
+
+	srv := &server.Server{
+		HTTP:            httpServer,
+		HTTPListener:    httpLis,
+		HTTPSListener:   httpsLis,
+		GRPC:            grpc.New(cachedSpaceClient, cachedNumbersClient, cachedNASAClient, grpcOpts...),
+		GRPCListener:    grpcLis,
+		Stopping:        stopping,
+		ShutdownTimeout: cfg.ShutdownTimeout,
+	}
+
+	// Also answer health checks in plaintext, for the kubelet's gRPC probes
+	// which cannot use TLS
+	if cfg.GRPCHealthAddr != "" {
+		if srv.HealthGRPCListener, err = net.Listen("tcp", cfg.GRPCHealthAddr); err != nil {
+			log.Fatal(err)
+		}
+		srv.HealthGRPC = grpc.NewHealthServer(health)
+	}
+
+	// Run until Kubernetes (SIGTERM) or the terminal (SIGINT) asks us to stop
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := srv.Run(ctx); err != nil {
+		log.Printf("Shutdown failed: %v", err)
+		stop()
+		os.Exit(1)
+	}
+	log.Printf("Shutdown complete")
 }